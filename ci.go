@@ -0,0 +1,192 @@
+package main
+
+import (
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CI describes a continuous integration service and the pipeline
+// file gobi creates for it on the root directory of a project
+type CI struct {
+	Name     string
+	File     string
+	Template string
+}
+
+// All supported continuous integration services
+var ciList = []*CI{ciGithub, ciGitlab, ciDrone, ciWoodpecker}
+
+var ciGithub = &CI{
+	Name:     "github",
+	File:     filepath.Join(".github", "workflows", "ci.yml"),
+	Template: filepath.Join("ci", "github.yml.tpl"),
+}
+
+var ciGitlab = &CI{
+	Name:     "gitlab",
+	File:     ".gitlab-ci.yml",
+	Template: filepath.Join("ci", "gitlab.yml.tpl"),
+}
+
+var ciDrone = &CI{
+	Name:     "drone",
+	File:     ".drone.yml",
+	Template: filepath.Join("ci", "drone.yml.tpl"),
+}
+
+var ciWoodpecker = &CI{
+	Name:     "woodpecker",
+	File:     ".woodpecker.yml",
+	Template: filepath.Join("ci", "woodpecker.yml.tpl"),
+}
+
+// Go release assumed when the local one can't be known
+const fallbackGoVersion = "go1.23"
+
+// Minor release of a Go version like ´go1.23.4´ or ´devel go1.24-abcdef´
+var goMinorRelease = regexp.MustCompile(`go1\.([0-9]+)`)
+
+// Go version as ´1.N´ or ´1.N.P´
+var goVersion = regexp.MustCompile(`^1\.[0-9]+(\.[0-9]+)?$`)
+
+// localGoVersion is the release of the Go installation, like ´go1.23.4´
+func localGoVersion() string {
+	out, err := exec.Command("go", "env", "GOVERSION").Output()
+	if err != nil {
+		return fallbackGoVersion
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// latestGoVersions returns the two latest minor releases up to the
+// given Go version, the ones the pipeline runs with when none are given
+func latestGoVersions(version string) []string {
+	m := goMinorRelease.FindStringSubmatch(version)
+	if m == nil {
+		m = goMinorRelease.FindStringSubmatch(fallbackGoVersion)
+	}
+	minor, _ := strconv.Atoi(m[1])
+	return []string{"1." + strconv.Itoa(minor-1), "1." + strconv.Itoa(minor)}
+}
+
+// ciFromHost is the value of --ci when given without one,
+// meaning the default service of the host
+const ciFromHost = "host"
+
+// ciFlag is the name of a CI service, or ciFromHost
+// if the flag is given without value (´--ci´). A service
+// must then be given with ´=´, as in ´--ci=gitlab´
+type ciFlag string
+
+func (f *ciFlag) String() string {
+	return string(*f)
+}
+
+func (f *ciFlag) Set(value string) error {
+	if b, err := strconv.ParseBool(value); err == nil {
+		value = ""
+		if b {
+			value = ciFromHost
+		}
+	}
+	*f = ciFlag(value)
+	return nil
+}
+
+func (f *ciFlag) IsBoolFlag() bool {
+	return true
+}
+
+// ciByName returns the CI with the given name or nil if not supported
+func ciByName(name string) *CI {
+	for _, ci := range ciList {
+		if strings.EqualFold(ci.Name, name) {
+			return ci
+		}
+	}
+	return nil
+}
+
+// validateCI: Must be one of the supported services
+func validateCI(name string) bool {
+	return name == ciFromHost || ciByName(name) != nil
+}
+
+// validateGoVersion: Must be a Go release like ´1.22´ or ´1.22.1´
+func validateGoVersion(version string) bool {
+	return goVersion.MatchString(version)
+}
+
+// CI returns the continuous integration service of the Project, the one
+// given on the command line or the default of its host, or nil if none
+func (proj Project) CI() *CI {
+	if proj.Opts.CI == ciFromHost {
+		return ciByName(hosts[proj.Host].CI)
+	}
+	return ciByName(string(proj.Opts.CI))
+}
+
+// GoVersions the pipeline of the Project runs with
+func (proj Project) GoVersions() []string {
+	if len(proj.Opts.GoVersions) == 0 {
+		return latestGoVersions(localGoVersion())
+	}
+	return proj.Opts.GoVersions
+}
+
+// GoVersion the container image of the Project is built with,
+// the latest one its pipeline runs with
+func (proj Project) GoVersion() string {
+	versions := proj.GoVersions()
+	latest := versions[0]
+	for _, v := range versions[1:] {
+		if newerGoVersion(v, latest) {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// newerGoVersion returns true if the Go version a is newer than b
+func newerGoVersion(a, b string) bool {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, _ := strconv.Atoi(as[i])
+		bn, _ := strconv.Atoi(bs[i])
+		if an != bn {
+			return an > bn
+		}
+	}
+	return len(as) > len(bs)
+}
+
+// ModuleDir is the directory of the Project relative to its root one, where
+// the pipeline of a gRPC service runs as it is a module on its own
+func (proj Project) ModuleDir() string {
+	dir, err := filepath.Rel(proj.RootDir(), proj.Dir())
+	if err != nil {
+		return "."
+	}
+	return filepath.ToSlash(dir)
+}
+
+// RootGoGetName is the go get name of the root directory of the Project,
+// used as module path if it has no go.mod
+func (proj Project) RootGoGetName() string {
+	return GoGetName(proj.Host, proj.UserId, proj.FirstName)
+}
+
+// CreateCIFiles with the pipeline of the continuous integration
+// service of the Project, if there is one
+func (proj Project) CreateCIFiles() {
+	ci := proj.CI()
+	if ci == nil {
+		return
+	}
+	file := filepath.Join(proj.RootDir(), ci.File)
+	proj.journal.mkdirAll(filepath.Dir(file))
+	proj.CreateFileFromTemplate(file, ci.Template)
+}
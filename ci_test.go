@@ -0,0 +1,43 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLatestGoVersions(t *testing.T) {
+	tests := []struct {
+		version string
+		want    []string
+	}{
+		{"go1.23.4", []string{"1.22", "1.23"}},
+		{"go1.27", []string{"1.26", "1.27"}},
+		{"go1.25rc1", []string{"1.24", "1.25"}},
+		{"devel go1.28-0123abc Mon Oct 19 10:00:00 2026 +0000", []string{"1.27", "1.28"}},
+		{"", []string{"1.22", "1.23"}},
+	}
+	for _, test := range tests {
+		if got := latestGoVersions(test.version); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got %v, want %v", test.version, got, test.want)
+		}
+	}
+}
+
+func TestNewerGoVersion(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1.23", "1.22", true},
+		{"1.22", "1.23", false},
+		{"1.10", "1.9", true},
+		{"1.22.1", "1.22", true},
+		{"1.22", "1.22.1", false},
+		{"1.22", "1.22", false},
+	}
+	for _, test := range tests {
+		if got := newerGoVersion(test.a, test.b); got != test.want {
+			t.Errorf("%s newer than %s: got %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
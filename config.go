@@ -24,6 +24,9 @@ var (
 	GOBIPATH    = filepath.Join(SRCPATH, GITHUB, "fern4lvarez", "gobi")
 )
 
+// stdin is shared by every prompt, so buffered answers are not lost
+var stdin = bufio.NewReader(os.Stdin)
+
 // setGobiPath where the templates and the version file will be located
 func setGobiPath() {
 	if os.Getenv("GOBIPATH") != "" {
@@ -38,6 +41,8 @@ type UserConfig struct {
 	Host    string `json:"host"`
 	Email   string `json:"email"`
 	License string `json:"license"`
+	// Pipeline run after creating a project, by project type
+	Pipeline map[string][]Step `json:"pipeline,omitempty"`
 }
 
 // NewConfig promps a form and returns a UserConfig object
@@ -70,7 +75,7 @@ func NewConfig() *UserConfig {
 		promptForm["license"]["welcome2"])
 
 	// User config creation
-	conf := &UserConfig{name, userName, host, email, license}
+	conf := &UserConfig{Name: name, Id: userName, Host: host, Email: email, License: normalizeLicense(license)}
 	b, _ := json.Marshal(conf)
 	ioutil.WriteFile(GOBI_CONFIG, []byte(b), 0744)
 	return conf
@@ -89,19 +94,20 @@ func checkConfig() UserConfig {
 	if errUnmarshal := json.Unmarshal(b, &user); errRead != nil || errUnmarshal != nil {
 		return *NewConfig()
 	}
+	// Licenses of older configurations are given by their alias
+	user.License = normalizeLicense(user.License)
 	return user
 }
 
 // promptField to validate and save input value
 func promptField(validateFunc func(string) bool, welcomeMsg, errorMsg, welcome2Msg string) (resp string) {
-	reader := bufio.NewReader(os.Stdin)
 	c.Print(welcomeMsg)
-	resp, _ = reader.ReadString('\n')
+	resp, _ = stdin.ReadString('\n')
 	resp = strings.TrimSpace(resp)
 	for !validateFunc(resp) {
 		c.Println(errorMsg)
 		c.Print(welcome2Msg)
-		resp, _ = reader.ReadString('\n')
+		resp, _ = stdin.ReadString('\n')
 		resp = strings.TrimSpace(resp)
 	}
 	return
@@ -139,12 +145,9 @@ func validateEmail(email string) bool {
 	return true
 }
 
-// validateLicense: Must be one of the supported licenses
+// validateLicense: Must be an SPDX license expression of supported licenses,
+// given by their ids or aliases
 func validateLicense(license string) bool {
-	for _, l := range licenses {
-		if strings.EqualFold(license, l) {
-			return true
-		}
-	}
-	return false
+	_, _, err := parseLicenseExpression(license)
+	return err == nil
 }
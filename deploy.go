@@ -0,0 +1,144 @@
+package main
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Deploy describes a platform where web applications are deployed
+// and the files they need to be deployed there
+type Deploy struct {
+	Name string
+	// Files created on the directory of the project, by path. Paths
+	// can contain the placeholder {name}, the second name of the project
+	Files []DeployFile
+	// Container is true if the platform runs the image built
+	// by the container build files, which are created too
+	Container bool
+}
+
+// DeployFile created from a template of the web project type
+type DeployFile struct {
+	Path     string
+	Template string
+}
+
+// All supported deployment targets
+var deployList = []*Deploy{deployHeroku, deployK8s, deploySystemd, deployFly}
+
+// Heroku, cloudControl and other platforms using buildpacks
+var deployHeroku = &Deploy{
+	Name: "heroku",
+	Files: []DeployFile{
+		{"Procfile", "Procfile.tpl"},
+		{".godir", "godir.tpl"},
+	},
+}
+
+var deployK8s = &Deploy{
+	Name: "k8s",
+	Files: []DeployFile{
+		{filepath.Join("k8s", "deployment.yaml"), filepath.Join("k8s", "deployment.yaml.tpl")},
+		{filepath.Join("k8s", "service.yaml"), filepath.Join("k8s", "service.yaml.tpl")},
+	},
+	Container: true,
+}
+
+var deploySystemd = &Deploy{
+	Name: "systemd",
+	Files: []DeployFile{
+		{"{name}.service", "systemd.service.tpl"},
+	},
+}
+
+var deployFly = &Deploy{
+	Name: "fly",
+	Files: []DeployFile{
+		{"fly.toml", "fly.toml.tpl"},
+	},
+	Container: true,
+}
+
+// Target used when no one is given, as web applications
+// were only deployed with buildpacks before
+var defaultDeploy = deployHeroku
+
+// Characters not allowed on the names of services
+var notServiceName = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// deployByName returns the Deploy with the given name or nil if not supported
+func deployByName(name string) *Deploy {
+	for _, d := range deployList {
+		if strings.EqualFold(d.Name, name) {
+			return d
+		}
+	}
+	return nil
+}
+
+// validateDeploy: Must be one of the supported deployment targets
+func validateDeploy(name string) bool {
+	return deployByName(name) != nil
+}
+
+// Deploys returns the deployment targets of the Project,
+// the ones given on the command line or else the default one
+func (proj Project) Deploys() []*Deploy {
+	if len(proj.Opts.Deploy) == 0 {
+		return []*Deploy{defaultDeploy}
+	}
+	var deploys []*Deploy
+	for _, d := range deployList {
+		if proj.DeploysTo(d.Name) {
+			deploys = append(deploys, d)
+		}
+	}
+	return deploys
+}
+
+// DeploysTo returns true if the Project is deployed to the target
+func (proj Project) DeploysTo(name string) bool {
+	if len(proj.Opts.Deploy) == 0 {
+		return strings.EqualFold(name, defaultDeploy.Name)
+	}
+	for _, d := range proj.Opts.Deploy {
+		if strings.EqualFold(d, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasContainerDeploy returns true if any deployment
+// target of the Project runs its container image
+func (proj Project) HasContainerDeploy() bool {
+	for _, d := range proj.Deploys() {
+		if d.Container {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateDeployFiles of every deployment target of the Project
+func (proj Project) CreateDeployFiles() {
+	buildDir := proj.Dir()
+	for _, d := range proj.Deploys() {
+		for _, f := range d.Files {
+			file := filepath.Join(buildDir, strings.Replace(f.Path, "{name}", proj.SecondName, -1))
+			proj.journal.mkdirAll(filepath.Dir(file))
+			proj.CreateFileFromTemplate(file, filepath.Join(proj.Typ, f.Template))
+		}
+	}
+}
+
+// ServiceName of the Project on deployment targets, where names
+// can only have lowercase letters, digits and hyphens
+func (proj Project) ServiceName() string {
+	name := notServiceName.ReplaceAllString(strings.ToLower(proj.SecondName), "-")
+	if name = strings.Trim(name, "-"); name == "" {
+		return "app"
+	}
+	return name
+}
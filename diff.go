@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	c "github.com/wsxiaoys/terminal/color"
+)
+
+// Number of unchanged lines shown around every change
+const diffContext = 3
+
+// diffLine is a single line of a diff. Op is ' ' when the
+// line is in both texts, '-' when removed and '+' when added
+type diffLine struct {
+	Op   byte
+	Text string
+}
+
+// lineDiff compares two texts line by line and returns the
+// edits that turn a into b, based on their longest common subsequence
+func lineDiff(a, b string) []diffLine {
+	linesA := splitLines(a)
+	linesB := splitLines(b)
+	n, m := len(linesA), len(linesB)
+
+	// lcs[i][j] is the length of the longest common subsequence
+	// of linesA[i:] and linesB[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if linesA[i] == linesB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	diff := make([]diffLine, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case linesA[i] == linesB[j]:
+			diff = append(diff, diffLine{' ', linesA[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, diffLine{'-', linesA[i]})
+			i++
+		default:
+			diff = append(diff, diffLine{'+', linesB[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		diff = append(diff, diffLine{'-', linesA[i]})
+	}
+	for ; j < m; j++ {
+		diff = append(diff, diffLine{'+', linesB[j]})
+	}
+	return diff
+}
+
+// splitLines of a text, ignoring the trailing newline
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// printDiff between the existing content of a file and the new one,
+// showing only the changed lines and some context around them
+func printDiff(file, oldText, newText string) {
+	diff := lineDiff(oldText, newText)
+	fmt.Println(c.Sprintf("@{!w}--- %s (existing)", file))
+	fmt.Println(c.Sprintf("@{!w}+++ %s (new)", file))
+
+	// show marks which lines are close enough to a change
+	show := make([]bool, len(diff))
+	for i, l := range diff {
+		if l.Op == ' ' {
+			continue
+		}
+		for k := i - diffContext; k <= i+diffContext; k++ {
+			if k >= 0 && k < len(diff) {
+				show[k] = true
+			}
+		}
+	}
+
+	for i, l := range diff {
+		if !show[i] {
+			continue
+		}
+		if i > 0 && !show[i-1] {
+			c.Println("@c...")
+		}
+		switch l.Op {
+		case '-':
+			fmt.Println(c.Sprintf("@r-%s", l.Text))
+		case '+':
+			fmt.Println(c.Sprintf("@g+%s", l.Text))
+		default:
+			fmt.Println(" " + l.Text)
+		}
+	}
+}
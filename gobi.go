@@ -13,7 +13,7 @@ func main() {
 	if l := len(os.Args); l == 1 {
 		welcome()
 		checkConfig()
-	} else if l > 3 {
+	} else if l > 3 && !isProjectType(os.Args[1]) && !isLicenseCommand(os.Args[1]) {
 		commandLineError(wrongNumberOfArguments)
 	} else {
 		setGobiPath()
@@ -25,13 +25,15 @@ func main() {
 			showVersion()
 		case "help":
 			help()
-		case "cl", "pkg", "web":
-			if l < 3 {
-				commandLineError(noProjectName)
-			}
-			name := os.Args[2]
-			proj := NewProject(name, first, user)
+		case "license", "licenses":
+			licenseCommand(os.Args[2:], user)
+		case "cl", "pkg", "web", "api", "grpc", "worker", "repo", "lib", "wasm", "plugin":
+			name, opts := parseArgs(os.Args[2:])
+			proj := NewProject(name, first, opts.Override(user), opts)
 			proj.Create()
+			if opts.Check {
+				proj.RunPipeline(proj.Pipeline(user))
+			}
 		default:
 			commandLineError(wrongArgument)
 		}
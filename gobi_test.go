@@ -88,6 +88,12 @@ func TestGobiWeb(t *testing.T) {
 	assertCommand(t, true, "gobi web goweb/web")
 	assertCommand(t, false, "gobi web goweb")
 	assertCommand(t, true, "gobi web goweb2/web")
+	// Bootstrap and jQuery are vendored
+	for _, asset := range webAssets {
+		if _, err := os.Stat(filepath.Join(SRCPATH, GITHUB, "test", "goweb", "static", asset)); err != nil {
+			t.Errorf("%s not copied: %v", asset, err)
+		}
+	}
 	teardown()
 	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
 
@@ -101,6 +107,96 @@ func TestGobiWeb(t *testing.T) {
 	cleanupFiles(filepath.Join(SRCPATH, GOOGLE, "p", "goweb2"))
 }
 
+func TestGobiApi(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi api goapi")
+	assertCommand(t, true, "gobi api goapi/api")
+	assertCommand(t, false, "gobi api goapi")
+	assertCommand(t, true, "gobi api goapi2/api")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiGrpc(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi grpc gogrpc")
+	assertCommand(t, true, "gobi grpc gogrpc/grpc")
+	assertCommand(t, false, "gobi grpc gogrpc")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiPlugin(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi plugin goplugin --interface=net/http.Handler")
+	assertCommand(t, false, "gobi plugin goplugin2")
+	assertCommand(t, false, "gobi plugin goplugin2 --interface=io")
+	assertCommand(t, false, "gobi plugin goplugin2 --interface=io.EOF")
+	assertCommand(t, false, "gobi plugin goplugin2 --interface=foo/bar.Baz")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiDocker(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi cl godocker --docker")
+	assertCommand(t, true, "gobi web godocker/web --docker --port=8080")
+	assertCommand(t, false, "gobi pkg godocker/pkg --docker")
+	assertCommand(t, false, "gobi web godocker2 --port=foo")
+	assertCommand(t, false, "gobi web godocker2 --port=65536")
+	// The image is built with the latest Go version of the pipeline
+	assertCommand(t, true, "gobi cl godocker3 --docker --go-versions=1.22.1,1.21")
+	b, _ := ioutil.ReadFile(filepath.Join(SRCPATH, GITHUB, "test", "godocker3", "Dockerfile"))
+	if !strings.Contains(string(b), "FROM golang:1.22.1 AS build") {
+		t.Error("Latest Go version not found on Dockerfile")
+	}
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiDeploy(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi web godeploy --deploy=k8s --deploy=systemd,fly --port=8080")
+	assertCommand(t, true, "gobi web godeploy/heroku --deploy=heroku")
+	assertCommand(t, false, "gobi web godeploy2 --deploy=foo")
+	assertCommand(t, false, "gobi cl godeploy2 --deploy=k8s")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiCI(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi pkg goci --ci")
+	assertCommand(t, true, "gobi cl goci/cli --ci=gitlab --go-versions=1.22,1.23")
+	assertCommand(t, true, "gobi web goci2 --ci=drone --go-versions=1.21 --go-versions=1.22")
+	assertCommand(t, true, "gobi pkg goci3 --ci=woodpecker")
+	assertCommand(t, false, "gobi pkg goci4 --ci=travis")
+	assertCommand(t, false, "gobi pkg goci4 --ci --go-versions=go1.22")
+	assertCommand(t, false, "gobi pkg goci4 --ci gitlab")
+	// gRPC services generate their code before testing it
+	assertCommand(t, true, "gobi grpc goci5/api --ci=gitlab")
+	b, _ := ioutil.ReadFile(filepath.Join(SRCPATH, GITHUB, "test", "goci5", ".gitlab-ci.yml"))
+	if !strings.Contains(string(b), "- cd api") || !strings.Contains(string(b), "make tools generate") {
+		t.Error("Code generation not found on the pipeline of the gRPC service")
+	}
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiGrpcCheck(t *testing.T) {
+	// make generate needs protoc and its Go plugins
+	for _, tool := range []string{"protoc", "protoc-gen-go", "protoc-gen-go-grpc"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s not found on PATH", tool)
+		}
+	}
+	setupGithub()
+	assertCommand(t, true, "gobi grpc gogrpccheck --check")
+	assertCommand(t, true, "gobi grpc gogrpccheck/grpc --check")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
 func TestGobiMix(t *testing.T) {
 	setupGithub()
 	assertCommand(t, true, "gobi pkg gomix")
@@ -119,6 +215,155 @@ func TestGobiMix(t *testing.T) {
 	cleanupFiles(filepath.Join(SRCPATH, GOOGLE, "p", "gomix"))
 }
 
+func TestGobiConflict(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi pkg goconflict")
+	assertCommand(t, false, "gobi pkg goconflict")
+	assertCommand(t, true, "gobi pkg goconflict --on-conflict=skip")
+	assertCommand(t, true, "gobi pkg --on-conflict=overwrite goconflict")
+	assertCommand(t, true, "gobi pkg goconflict --on-conflict=backup")
+	assertCommand(t, false, "gobi pkg goconflict --on-conflict=foo")
+	assertCommand(t, false, "gobi pkg goconflict --foo")
+	assertCommand(t, false, "gobi pkg --on-conflict=skip")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiGit(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi cl gogit --git")
+	assertCommand(t, true, "gobi pkg gogit/lib --git --branch=main")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiVCS(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi cl govcs --vcs=hg")
+	assertCommand(t, true, "gobi cl govcs2 --vcs=fossil")
+	assertCommand(t, true, "gobi cl govcs3 --vcs=git --init")
+	assertCommand(t, false, "gobi cl govcs4 --vcs=svn")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiCheck(t *testing.T) {
+	// Checks must pass on the default module mode of Go
+	t.Setenv("GO111MODULE", "")
+	setupGithub()
+	assertCommand(t, true, "gobi cl gocheck --check")
+	assertCommand(t, true, "gobi pkg gocheck/pkg --check")
+	assertCommand(t, true, "gobi web gocheck/web --check")
+	assertCommand(t, true, "gobi api gocheck/api --check")
+	assertCommand(t, true, "gobi worker gocheck/worker --check")
+	assertCommand(t, true, "gobi repo gocheck/repo --check")
+	assertCommand(t, true, "gobi lib gocheck/lib --check")
+	assertCommand(t, true, "gobi wasm gocheck/wasm --check")
+	assertCommand(t, true, "gobi plugin gocheck/plugin --interface=io.ReadWriteCloser --check")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiHooks(t *testing.T) {
+	setupGithub()
+	defer teardown()
+	defer cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+
+	// Template pack with hooks on the pkg manifest
+	pack := filepath.Join(GITHUB, "test", "gobipack")
+	copyDir(t, filepath.Join(GOBIPATH, "templates"), filepath.Join(SRCPATH, pack, "templates"))
+	dir := filepath.Join(SRCPATH, pack, "templates", "pkg")
+	writeFile(t, filepath.Join(dir, "vars.sh"), "#!/bin/sh\necho GREETING=hola\n", 0755)
+	writeFile(t, filepath.Join(dir, "check.tpl"), "grep -q {{.Vars.GREETING}} README.md\n", 0644)
+	writeFile(t, filepath.Join(dir, "fail.tpl"), "echo {{.Name}} fails\nexit 1\n", 0644)
+	readme, _ := ioutil.ReadFile(filepath.Join(dir, "README.md.tpl"))
+	writeFile(t, filepath.Join(dir, "README.md.tpl"), string(readme)+"\n{{.Vars.GREETING}}\n", 0644)
+	t.Setenv("GOBIPATH", pack)
+
+	// Variables of pre hooks are used on templates
+	writeFile(t, filepath.Join(dir, "manifest.json"),
+		`{"hooks": {"pre": ["vars.sh"], "post": ["check.tpl"]}}`, 0644)
+	assertCommand(t, true, "gobi pkg gohooks")
+	b, _ := ioutil.ReadFile(filepath.Join(SRCPATH, GITHUB, "test", "gohooks", "README.md"))
+	if !strings.Contains(string(b), "hola") {
+		t.Error("Variable of the pre hook not found on README.md")
+	}
+
+	// A failing post hook rolls back the project
+	writeFile(t, filepath.Join(dir, "manifest.json"),
+		`{"hooks": {"pre": ["vars.sh"], "post": ["fail.tpl"]}}`, 0644)
+	assertCommand(t, false, "gobi pkg gohooks2")
+	if _, err := os.Stat(filepath.Join(SRCPATH, GITHUB, "test", "gohooks2")); !os.IsNotExist(err) {
+		t.Error("Project not rolled back after a failing post hook")
+	}
+}
+
+func TestGobiLicense(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi license list")
+	assertCommand(t, true, "gobi license show MIT")
+	assertCommand(t, true, "gobi license show apache-2.0")
+	assertCommand(t, true, "gobi license show Mozilla")
+	assertCommand(t, false, "gobi license show foo")
+	assertCommand(t, false, "gobi license show")
+	assertCommand(t, false, "gobi license")
+	teardown()
+
+	setupGithub()
+	assertCommand(t, true, "gobi pkg goheader")
+	assertCommand(t, true, "gobi license apply "+filepath.Join(SRCPATH, GITHUB, "test", "goheader"))
+	assertCommand(t, true, "gobi license apply --license=Apache-2.0 "+filepath.Join(SRCPATH, GITHUB, "test", "goheader"))
+	assertCommand(t, true, "gobi license apply "+filepath.Join(SRCPATH, GITHUB, "test", "goheader")+" --license=MIT")
+	assertCommand(t, false, "gobi license apply . . --license=MIT")
+	assertCommand(t, false, "gobi license apply --license=foo")
+	teardown()
+
+	setupGithub()
+	assertCommand(t, true, "gobi pkg goreport")
+	reportDir := filepath.Join(SRCPATH, GITHUB, "test", "goreport")
+	assertCommand(t, false, "gobi licenses report "+reportDir)
+	ioutil.WriteFile(filepath.Join(reportDir, "go.mod"),
+		[]byte("module github.com/test/goreport\n\nrequire github.com/test/missing v1.0.0\n"), 0644)
+	assertCommand(t, true, "gobi licenses report "+reportDir)
+	assertCommand(t, true, "gobi licenses report "+reportDir+" --output=NOTICES --license=Apache-2.0")
+	if _, err := os.Stat(filepath.Join(reportDir, "NOTICES")); err != nil {
+		t.Errorf("NOTICES not created: %v", err)
+	}
+	teardown()
+
+	// License expressions create a file for every license
+	setup("Test", "test", GITHUB, "test@mail.com", "MIT OR Apache-2.0")
+	assertCommand(t, true, "gobi pkg godual")
+	for _, file := range []string{"LICENSE-MIT", "LICENSE-APACHE"} {
+		if _, err := os.Stat(filepath.Join(SRCPATH, GITHUB, "test", "godual", file)); err != nil {
+			t.Errorf("%s not created: %v", file, err)
+		}
+	}
+	assertCommand(t, false, "gobi pkg godual2 --license=(MIT")
+	assertCommand(t, false, "gobi pkg godual2 --license=MIT)")
+	teardown()
+
+	// Legacy license names are still supported
+	setup("Test", "test", GITHUB, "test@mail.com", "GPLv3")
+	assertCommand(t, true, "gobi pkg golicense")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+}
+
+func TestGobiOverrides(t *testing.T) {
+	setupGithub()
+	assertCommand(t, true, "gobi pkg gooverride --license=Apache-2.0")
+	assertCommand(t, true, "gobi cl gooverride/cli --id=test --author=Tester")
+	assertCommand(t, true, "gobi web gooverride2 --host=bitbucket.org --id=test")
+	assertCommand(t, false, "gobi pkg gooverride3 --license=foo")
+	assertCommand(t, false, "gobi pkg gooverride3 --host=example.com")
+	assertCommand(t, false, "gobi pkg gooverride3 --id=foo/bar")
+	assertCommand(t, true, "gobi whoami")
+	teardown()
+	cleanupFiles(filepath.Join(SRCPATH, GITHUB, "test"))
+	cleanupFiles(filepath.Join(SRCPATH, BITBUCKET, "test"))
+}
+
 func assertCommand(t *testing.T, b bool, cmd string) {
 	c.Println("@{!b} $", cmd)
 	cmdSl := strings.Split(cmd, " ")
@@ -163,7 +408,7 @@ func teardown() {
 }
 
 func createTestConfig(name, userName, host, email, license string) {
-	conf := &UserConfig{name, userName, host, email, license}
+	conf := &UserConfig{Name: name, Id: userName, Host: host, Email: email, License: license}
 	b, _ := json.Marshal(conf)
 	ioutil.WriteFile(GOBI_CONFIG, []byte(b), 0744)
 }
@@ -171,3 +416,29 @@ func createTestConfig(name, userName, host, email, license string) {
 func cleanupFiles(path string) {
 	os.RemoveAll(path)
 }
+
+func writeFile(t *testing.T, file, content string, mode os.FileMode) {
+	if err := ioutil.WriteFile(file, []byte(content), mode); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func copyDir(t *testing.T, src, dst string) {
+	err := filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(src, path)
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, rel), 0755)
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dst, rel), b, info.Mode())
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// headerFormat matches the license header written by gobi for a holder,
+// with or without SPDX id, capturing its year. Other headers are kept,
+// as they can belong to others
+const headerFormat = `^(?:// SPDX-License-Identifier: [^\n]+\n)?// Copyright \(c\) ([0-9]{4}) %s\n(?:\n|$)`
+
+// licenseHeader returns the comment placed at the top of every Go
+// source file, with the SPDX id of the license and the copyright
+func licenseHeader(license, holder string, year int) string {
+	header := fmt.Sprintf("// Copyright (c) %d %s\n", year, holder)
+	if license != "" && license != "NONE" {
+		header = "// SPDX-License-Identifier: " + license + "\n" + header
+	}
+	return header + "\n"
+}
+
+// Header returns the license header of the source files of the Project
+func (proj Project) Header() string {
+	return licenseHeader(proj.License, proj.UserName, proj.Year)
+}
+
+// withHeader returns the source with the license header of a license and
+// holder. A header written by gobi for the holder is replaced keeping its
+// year, and the header is added on top of any other one
+func withHeader(src, license, holder string, year int) string {
+	header := regexp.MustCompile(fmt.Sprintf(headerFormat, regexp.QuoteMeta(holder)))
+	m := header.FindStringSubmatch(src)
+	if m == nil {
+		return licenseHeader(license, holder, year) + src
+	}
+	year, _ = strconv.Atoi(m[1])
+	return licenseHeader(license, holder, year) + src[len(m[0]):]
+}
+
+// applyHeaders adds or updates the license header of every Go source
+// file under a directory, as ´gobi license apply´ does
+func applyHeaders(args []string, user UserConfig) {
+	fs := flag.NewFlagSet("apply", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	license := fs.String("license", user.License, "")
+	dir := parseDirArgs(fs, args)
+	if !validateLicense(*license) {
+		commandLineError(wrongLicense)
+	}
+
+	id := normalizeLicense(*license)
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if path != dir && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") ||
+				name == "vendor" || name == "testdata" || name == "Godeps") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		if src := withHeader(string(b), id, user.Name, time.Now().Year()); src != string(b) {
+			ioutil.WriteFile(path, []byte(src), info.Mode())
+			headerApplied(path)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,53 @@
+package main
+
+import "testing"
+
+func TestWithHeader(t *testing.T) {
+	goAuthors := "// Copyright 2009 The Go Authors. All rights reserved.\n" +
+		"// Use of this source code is governed by a BSD-style\n" +
+		"// license that can be found in the LICENSE file.\n\npackage foo\n"
+	tests := []struct {
+		name, src, want string
+	}{
+		{"no header", "package foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\npackage foo\n"},
+		{"package doc", "// Package foo does foo\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\n// Package foo does foo\npackage foo\n"},
+		{"gobi header", "// SPDX-License-Identifier: Apache-2.0\n// Copyright (c) 2013 Test\n\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2013 Test\n\npackage foo\n"},
+		{"up to date", "// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\npackage foo\n"},
+		{"third party header", goAuthors,
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\n" + goAuthors},
+		{"copyright only", "// Copyright (c) 2013 Other\n\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\n// Copyright (c) 2013 Other\n\npackage foo\n"},
+		{"third party SPDX header", "// SPDX-License-Identifier: Apache-2.0\n// Copyright 2020 Google LLC\n\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\n// SPDX-License-Identifier: Apache-2.0\n// Copyright 2020 Google LLC\n\npackage foo\n"},
+		{"SPDX header of other holder", "// SPDX-License-Identifier: Apache-2.0\n// Copyright (c) 2013 Other\n\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2042 Test\n\n// SPDX-License-Identifier: Apache-2.0\n// Copyright (c) 2013 Other\n\npackage foo\n"},
+		{"gobi header without license", "// Copyright (c) 2013 Test\n\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2013 Test\n\npackage foo\n"},
+		{"gobi header of an expression", "// SPDX-License-Identifier: MIT OR Apache-2.0\n// Copyright (c) 2013 Test\n\npackage foo\n",
+			"// SPDX-License-Identifier: MIT\n// Copyright (c) 2013 Test\n\npackage foo\n"},
+	}
+	for _, test := range tests {
+		if got := withHeader(test.src, "MIT", "Test", 2042); got != test.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestWithHeaderNoLicense(t *testing.T) {
+	src := "package foo\n"
+	want := "// Copyright (c) 2013 Test\n\npackage foo\n"
+	// Applying it again doesn't stack headers
+	for i := 0; i < 2; i++ {
+		if src = withHeader(src, "NONE", "Test", 2013); src != want {
+			t.Fatalf("NONE applied %d times: got\n%s\nwant\n%s", i+1, src, want)
+		}
+	}
+	want = "// SPDX-License-Identifier: MIT\n// Copyright (c) 2013 Test\n\npackage foo\n"
+	if got := withHeader(src, "MIT", "Test", 2042); got != want {
+		t.Errorf("MIT after NONE: got\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// Pre hooks add variables printing lines like ´KEY=VALUE´
+var hookVar = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)=(.*)$`)
+
+// RunPreHooks of the manifest before any file is written, adding the
+// variables they print to the Project. The program exits if any of them fails
+func (proj *Project) RunPreHooks() {
+	for _, hook := range proj.manifest.Hooks.Pre {
+		var out bytes.Buffer
+		if err := proj.runHook(hook, filepath.Join(GOBIPATH, "templates", proj.Typ), &out); err != nil {
+			hookFailed(hook, err)
+			os.Exit(1)
+		}
+		for _, line := range strings.Split(out.String(), "\n") {
+			if m := hookVar.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+				proj.Vars[m[1]] = m[2]
+			} else if line != "" {
+				fmt.Println(line)
+			}
+		}
+	}
+}
+
+// RunPostHooks of the manifest on the directory of the Project
+// If any of them fails, all changes are rolled back and the program exits
+func (proj *Project) RunPostHooks() {
+	for _, hook := range proj.manifest.Hooks.Post {
+		if err := proj.runHook(hook, proj.Dir(), os.Stdout); err != nil {
+			hookFailed(hook, err)
+			proj.journal.rollback()
+			os.Exit(1)
+		}
+	}
+}
+
+// runHook on a directory writing its standard output to stdout
+// Go template scripts are rendered with the Project and run with sh
+func (proj Project) runHook(hook, dir string, stdout io.Writer) error {
+	hookRun(hook)
+	path := filepath.Join(GOBIPATH, "templates", proj.Typ, hook)
+	cmd := exec.Command(path)
+	if strings.HasSuffix(hook, ".tpl") {
+		t, err := template.ParseFiles(path)
+		if err != nil {
+			return err
+		}
+		var script bytes.Buffer
+		if err := t.Execute(&script, proj); err != nil {
+			return err
+		}
+		cmd = exec.Command("sh", "-s")
+		cmd.Stdin = &script
+	}
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), proj.Env()...)
+	cmd.Stdout = stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// Env returns the environment variables describing the Project to its hooks
+func (proj Project) Env() []string {
+	env := []string{
+		"GOBI_NAME=" + proj.Name,
+		"GOBI_FIRST_NAME=" + proj.FirstName,
+		"GOBI_SECOND_NAME=" + proj.SecondName,
+		"GOBI_GO_GET_NAME=" + proj.GoGetName,
+		"GOBI_USER_ID=" + proj.UserId,
+		"GOBI_USER_NAME=" + proj.UserName,
+		"GOBI_USER_EMAIL=" + proj.UserEmail,
+		"GOBI_HOST=" + proj.Host,
+		"GOBI_LICENSE=" + proj.License,
+		"GOBI_TYPE=" + proj.Typ,
+		"GOBI_DIR=" + proj.Dir(),
+		"GOBI_ROOT_DIR=" + proj.RootDir(),
+	}
+	for k, v := range proj.Vars {
+		env = append(env, "GOBI_VAR_"+k+"="+v)
+	}
+	return env
+}
@@ -0,0 +1,21 @@
+package main
+
+// Host contains the information gobi needs about
+// a place where projects are published
+type Host struct {
+	Name string
+	// Remote is the format of the repository url,
+	// filled with the go get name of the root of the Project
+	Remote string
+	// VCS used by default for projects on this host
+	VCS string
+	// CI service used by default for projects on this host
+	CI string
+}
+
+// hosts registry with all supported hosts
+var hosts = map[string]Host{
+	GITHUB:    Host{GITHUB, "https://%s.git", "git", "github"},
+	BITBUCKET: Host{BITBUCKET, "https://%s.git", "git", "drone"},
+	GOOGLE:    Host{GOOGLE, "https://%s", "hg", "drone"},
+}
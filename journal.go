@@ -0,0 +1,68 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// journal records the changes made on disk while creating a Project,
+// so they can be undone if the creation is aborted
+type journal struct {
+	changes []change
+}
+
+// change on disk. old is the previous content of an overwritten file
+type change struct {
+	path    string
+	existed bool
+	old     []byte
+}
+
+// mkdirAll creates a directory and its parents, recording the topmost
+// directory created
+func (j *journal) mkdirAll(dir string) error {
+	top := ""
+	for d := dir; ; d = filepath.Dir(d) {
+		if _, err := os.Stat(d); err == nil || d == filepath.Dir(d) {
+			break
+		}
+		top = d
+	}
+	if top == "" {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0744); err != nil {
+		return err
+	}
+	j.changes = append(j.changes, change{path: top})
+	return nil
+}
+
+// writeFile recording the previous content if the file existed
+func (j *journal) writeFile(file string, data []byte) error {
+	old, err := ioutil.ReadFile(file)
+	existed := err == nil
+	if err := j.mkdirAll(filepath.Dir(file)); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(file, data, 0666); err != nil {
+		return err
+	}
+	j.changes = append(j.changes, change{file, existed, old})
+	return nil
+}
+
+// rollback undoes all recorded changes, from the last to the first one
+func (j *journal) rollback() {
+	for i := len(j.changes) - 1; i >= 0; i-- {
+		ch := j.changes[i]
+		if ch.existed {
+			ioutil.WriteFile(ch.path, ch.old, 0666)
+		} else {
+			os.RemoveAll(ch.path)
+		}
+		rolledBack(ch.path)
+	}
+	j.changes = nil
+}
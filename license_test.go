@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+// Placeholders that license templates must not leave behind
+var placeholders = regexp.MustCompile(`(?i)<no value>|[<{\[](year|yyyy|name of (author|copyright owner)|owner|organization|fullname|one line to give[^>}\]]*)[>}\]]|year name of author|Copyright \(C\)\s*\n|2013`)
+
+func TestLicenseTemplates(t *testing.T) {
+	proj := Project{
+		Name:        "gotest/sub",
+		FirstName:   "gotest",
+		SecondName:  "sub",
+		UserName:    "Test",
+		UserEmail:   "test@mail.com",
+		Description: "A package written in Go",
+		Year:        2042,
+	}
+	for _, license := range licenses {
+		tmpl, err := template.ParseFiles(filepath.Join("templates", "license", license.Template))
+		if err != nil {
+			t.Errorf("%s: %v", license.ID, err)
+			continue
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, proj); err != nil {
+			t.Errorf("%s: %v", license.ID, err)
+			continue
+		}
+		if p := placeholders.Find(buf.Bytes()); p != nil {
+			t.Errorf("%s has a leftover placeholder: %q", license.ID, p)
+		}
+	}
+}
+
+func TestParseLicenseExpression(t *testing.T) {
+	tests := []struct {
+		expr, want string
+		ids        []string
+	}{
+		{"MIT", "MIT", []string{"MIT"}},
+		{"mit or apache", "MIT OR Apache-2.0", []string{"MIT", "Apache-2.0"}},
+		{"(MIT AND BSD-2-Clause) OR GPLv3", "(MIT AND BSD-2-Clause) OR GPL-3.0-or-later",
+			[]string{"MIT", "BSD-2-Clause", "GPL-3.0-or-later"}},
+		{"GPL-2.0-or-later WITH Classpath-exception-2.0", "GPL-2.0-or-later WITH Classpath-exception-2.0",
+			[]string{"GPL-2.0-or-later"}},
+		{"MIT OR (MIT AND Apache-2.0)", "MIT OR (MIT AND Apache-2.0)", []string{"MIT", "Apache-2.0"}},
+	}
+	for _, test := range tests {
+		got, list, err := parseLicenseExpression(test.expr)
+		if err != nil {
+			t.Errorf("%q: %v", test.expr, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("%q: got %q, want %q", test.expr, got, test.want)
+		}
+		var ids []string
+		for _, license := range list {
+			ids = append(ids, license.ID)
+		}
+		if strings.Join(ids, " ") != strings.Join(test.ids, " ") {
+			t.Errorf("%q: got licenses %v, want %v", test.expr, ids, test.ids)
+		}
+	}
+
+	for _, expr := range []string{"", "foo", "(MIT", "MIT)", "()", "MIT OR", "OR MIT",
+		"MIT Apache-2.0", "MIT WITH", "MIT WITH )", "MIT XOR Apache-2.0"} {
+		if _, _, err := parseLicenseExpression(expr); err != errLicenseExpression {
+			t.Errorf("%q: got error %v, want %v", expr, err, errLicenseExpression)
+		}
+	}
+}
+
+func TestLicenseFiles(t *testing.T) {
+	tests := []struct {
+		expr  string
+		files []string
+	}{
+		{"MIT", []string{"LICENSE"}},
+		{"Mozilla", []string{"LICENSE"}},
+		{"MIT OR MIT", []string{"LICENSE"}},
+		{"MIT OR Apache-2.0", []string{"LICENSE-MIT", "LICENSE-APACHE"}},
+		{"GPL-2.0-only OR GPL-3.0-only", []string{"LICENSE-GPL-2.0-ONLY", "LICENSE-GPL-3.0-ONLY"}},
+		{"MIT AND (BSD-2-Clause OR BSD-3-Clause)",
+			[]string{"LICENSE-MIT", "LICENSE-BSD-2-CLAUSE", "LICENSE-BSD-3-CLAUSE"}},
+	}
+	for _, test := range tests {
+		var files []string
+		for _, file := range licenseFiles(test.expr) {
+			files = append(files, file.Name)
+		}
+		if strings.Join(files, " ") != strings.Join(test.files, " ") {
+			t.Errorf("%q: got %v, want %v", test.expr, files, test.files)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	c "github.com/wsxiaoys/terminal/color"
+)
+
+// License identified by its SPDX id (https://spdx.org/licenses)
+// Template is the file on templates/license used to create it, and
+// Aliases are the names used by older versions of gobi
+type License struct {
+	ID       string
+	Name     string
+	Template string
+	Aliases  []string
+}
+
+// All supported licenses
+type Licenses []License
+
+// print all license ids
+func (l Licenses) print() string {
+	ids := make([]string, len(l))
+	for i, license := range l {
+		ids[i] = license.ID
+	}
+	return strings.Join(ids, ", ")
+}
+
+// find a license by its id or any of its aliases, ignoring the case
+func (l Licenses) find(id string) (License, bool) {
+	for _, license := range l {
+		if strings.EqualFold(id, license.ID) {
+			return license, true
+		}
+		for _, alias := range license.Aliases {
+			if strings.EqualFold(id, alias) {
+				return license, true
+			}
+		}
+	}
+	return License{}, false
+}
+
+// licenses catalogue
+var licenses = Licenses{
+	{"AGPL-3.0-only", "GNU Affero General Public License v3.0 only", "AGPL-3.0.tpl", []string{"AGPL-3.0"}},
+	{"AGPL-3.0-or-later", "GNU Affero General Public License v3.0 or later", "AGPL-3.0.tpl", []string{"AGPL"}},
+	{"Apache-2.0", "Apache License 2.0", "Apache-2.0.tpl", []string{"Apache"}},
+	{"BSD-2-Clause", "BSD 2-Clause \"Simplified\" License", "BSD-2-Clause.tpl", []string{"BSD"}},
+	{"BSD-3-Clause", "BSD 3-Clause \"New\" or \"Revised\" License", "BSD-3-Clause.tpl", []string{"BSD3-Clause"}},
+	{"CC0-1.0", "Creative Commons Zero v1.0 Universal", "CC0-1.0.tpl", []string{"PublicDomain"}},
+	{"EPL-1.0", "Eclipse Public License 1.0", "EPL-1.0.tpl", []string{"Eclipse"}},
+	{"GPL-2.0-only", "GNU General Public License v2.0 only", "GPL-2.0.tpl", []string{"GPL-2.0"}},
+	{"GPL-2.0-or-later", "GNU General Public License v2.0 or later", "GPL-2.0.tpl", []string{"GPLv2"}},
+	{"GPL-3.0-only", "GNU General Public License v3.0 only", "GPL-3.0.tpl", []string{"GPL-3.0"}},
+	{"GPL-3.0-or-later", "GNU General Public License v3.0 or later", "GPL-3.0.tpl", []string{"GPLv3"}},
+	{"LGPL-2.1-only", "GNU Lesser General Public License v2.1 only", "LGPL-2.1.tpl", []string{"LGPL-2.1"}},
+	{"LGPL-2.1-or-later", "GNU Lesser General Public License v2.1 or later", "LGPL-2.1.tpl", []string{"LGPLv2.1"}},
+	{"LGPL-3.0-only", "GNU Lesser General Public License v3.0 only", "LGPL-3.0.tpl", []string{"LGPL-3.0"}},
+	{"LGPL-3.0-or-later", "GNU Lesser General Public License v3.0 or later", "LGPL-3.0.tpl", []string{"LGPLv3"}},
+	{"MIT", "MIT License", "MIT.tpl", nil},
+	{"MPL-2.0", "Mozilla Public License 2.0", "MPL-2.0.tpl", []string{"Mozilla"}},
+	{"NONE", "No license, all rights reserved", "NONE.tpl", []string{"no-license"}},
+	{"WTFPL", "Do What The F*ck You Want To Public License", "WTFPL.tpl", nil},
+}
+
+// normalizeLicense returns an SPDX license expression with the ids of its
+// licenses instead of their aliases. Wrong expressions are returned as they are
+func normalizeLicense(expr string) string {
+	if normalized, _, err := parseLicenseExpression(expr); err == nil {
+		return normalized
+	}
+	return expr
+}
+
+// errLicenseExpression is returned for wrong SPDX license expressions
+var errLicenseExpression = errors.New("wrong license expression")
+
+// parseLicenseExpression like ´MIT OR Apache-2.0´ or ´(MIT AND BSD-2-Clause) OR
+// GPL-3.0-or-later´, returning it normalized and the licenses it contains
+func parseLicenseExpression(expr string) (string, []License, error) {
+	expr = strings.Replace(expr, "(", " ( ", -1)
+	expr = strings.Replace(expr, ")", " ) ", -1)
+	p := &licenseParser{tokens: strings.Fields(expr)}
+	normalized, err := p.expression()
+	if err == nil && p.pos < len(p.tokens) {
+		err = errLicenseExpression
+	}
+	return normalized, p.licenses, err
+}
+
+// licenseParser of SPDX license expressions
+type licenseParser struct {
+	tokens   []string
+	pos      int
+	licenses []License
+}
+
+// next token of the expression, or empty if there are no more
+func (p *licenseParser) next() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// expression := term ((AND | OR) term)*
+func (p *licenseParser) expression() (string, error) {
+	expr, err := p.term()
+	for err == nil {
+		op := strings.ToUpper(p.next())
+		if op != "AND" && op != "OR" {
+			break
+		}
+		p.pos++
+		var right string
+		right, err = p.term()
+		expr += " " + op + " " + right
+	}
+	return expr, err
+}
+
+// term := "(" expression ")" | id [WITH exception]
+func (p *licenseParser) term() (string, error) {
+	token := p.next()
+	p.pos++
+	if token == "(" {
+		expr, err := p.expression()
+		if err != nil || p.next() != ")" {
+			return "", errLicenseExpression
+		}
+		p.pos++
+		return "(" + expr + ")", nil
+	}
+
+	license, ok := licenses.find(token)
+	if !ok {
+		return "", errLicenseExpression
+	}
+	p.add(license)
+	if strings.EqualFold(p.next(), "WITH") {
+		p.pos++
+		exception := p.next()
+		if exception == "" || exception == "(" || exception == ")" {
+			return "", errLicenseExpression
+		}
+		p.pos++
+		return license.ID + " WITH " + exception, nil
+	}
+	return license.ID, nil
+}
+
+// add a license to the parsed ones, if it was not found before
+func (p *licenseParser) add(license License) {
+	for _, l := range p.licenses {
+		if l.ID == license.ID {
+			return
+		}
+	}
+	p.licenses = append(p.licenses, license)
+}
+
+// LicenseFile is a license file to create on a Project
+type LicenseFile struct {
+	Name     string
+	Template string
+}
+
+// licenseFiles returns the files needed for a license expression. A single
+// license is written on LICENSE, and several ones on LICENSE-<NAME>
+// (i.e. LICENSE-MIT and LICENSE-APACHE for ´MIT OR Apache-2.0´)
+func licenseFiles(expr string) []LicenseFile {
+	_, list, _ := parseLicenseExpression(expr)
+	if len(list) == 1 {
+		return []LicenseFile{{"LICENSE", list[0].Template}}
+	}
+
+	files := make([]LicenseFile, len(list))
+	for i, license := range list {
+		suffix := strings.ToUpper(strings.SplitN(license.ID, "-", 2)[0])
+		for _, other := range list {
+			if other.ID != license.ID && strings.HasPrefix(strings.ToUpper(other.ID), suffix+"-") {
+				suffix = strings.ToUpper(license.ID)
+			}
+		}
+		files[i] = LicenseFile{"LICENSE-" + suffix, license.Template}
+	}
+	return files
+}
+
+// isLicenseCommand returns true for ´gobi license´ and ´gobi licenses´
+func isLicenseCommand(cmd string) bool {
+	return cmd == "license" || cmd == "licenses"
+}
+
+// licenseCommand runs the ´gobi license´ subcommands
+func licenseCommand(args []string, user UserConfig) {
+	if len(args) == 0 {
+		commandLineError(noLicenseCommand)
+	}
+	switch args[0] {
+	case "list":
+		if len(args) > 1 {
+			commandLineError(wrongNumberOfArguments)
+		}
+		listLicenses()
+	case "show":
+		if len(args) != 2 {
+			commandLineError(noLicenseID)
+		}
+		showLicense(args[1])
+	case "apply":
+		applyHeaders(args[1:], user)
+	case "report":
+		reportLicenses(args[1:], user)
+	default:
+		commandLineError(wrongArgument)
+	}
+}
+
+// listLicenses of the catalogue with their names and aliases
+func listLicenses() {
+	for _, license := range licenses {
+		aliases := ""
+		if len(license.Aliases) > 0 {
+			aliases = fmt.Sprintf(" (%s)", strings.Join(license.Aliases, ", "))
+		}
+		fmt.Println(c.Sprintf("  @c- @{!y}%-18s@w%s@b%s", license.ID, license.Name, aliases))
+	}
+}
+
+// showLicense prints the template of a license
+func showLicense(id string) {
+	license, ok := licenses.find(id)
+	if !ok {
+		commandLineError(wrongLicense)
+	}
+	b, _ := ioutil.ReadFile(filepath.Join(GOBIPATH, "templates", "license", license.Template))
+	fmt.Println(c.Sprintf("@{!b}%s @b(%s)", license.Name, license.ID))
+	fmt.Println(string(b))
+}
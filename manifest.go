@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+)
+
+// Manifest describes how a project type of the templates is built.
+// It is read from templates/<type>/manifest.json
+type Manifest struct {
+	Pipeline []Step `json:"pipeline"`
+	Hooks    Hooks  `json:"hooks"`
+}
+
+// Hooks are executables or Go template scripts (ending with .tpl) relative
+// to the directory of the project type. Pre hooks run before rendering and
+// can add variables printing ´KEY=VALUE´ lines. Post hooks run on the
+// directory of the project after writing it
+type Hooks struct {
+	Pre  []string `json:"pre"`
+	Post []string `json:"post"`
+}
+
+// loadManifest of a project type
+// A missing or invalid manifest is returned empty
+func loadManifest(typ string) Manifest {
+	var m Manifest
+	b, err := ioutil.ReadFile(filepath.Join(GOBIPATH, "templates", typ, "manifest.json"))
+	if err == nil {
+		json.Unmarshal(b, &m)
+	}
+	return m
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
@@ -19,14 +20,6 @@ const logo = `
   |___/  
 `
 
-// All supported licenses
-type Licenses []string
-
-// print all licenses
-func (l Licenses) print() string {
-	return strings.Join(l, ", ")
-}
-
 // global variables used as print messages
 var (
 	// Command line errors
@@ -34,7 +27,29 @@ var (
 	wrongArgument          = "@{!r}Wrong argument, try again."
 	noProjectName          = "@{!r}You need to specify a name."
 	wrongProjectName       = "@{!r}The project name is not valid."
-	projectExists          = "@{!y}Oops! Looks like this project already exists."
+	projectExists          = "@{!y}Oops! Looks like this project already exists. Use ´--on-conflict´ to regenerate it."
+	wrongConflictPolicy    = "@{!r}Wrong conflict policy. @rOptions: skip, overwrite, prompt or backup."
+	noLicenseCommand       = "@{!r}You need to specify ´list´, ´show <LICENSE>´, ´apply´ or ´report´."
+	noDependencies         = "@{!r}No go.mod or Godeps/Godeps.json found."
+	noLicenseID            = "@{!r}You need to specify a license id."
+	wrongLicense           = "@{!r}Unknown license. @rSee ´gobi license list´."
+	wrongHost              = "@{!r}Unsupported host. @rOptions: github.com, bitbucket.org or code.google.com."
+	wrongUserName          = "@{!r}Wrong username."
+	wrongAuthor            = "@{!r}Wrong author. @rUse ´Name´ or ´Name <email>´."
+	wrongVCS               = "@{!r}Unsupported version control system. @rOptions: git, hg or fossil."
+	wrongPort              = "@{!r}Wrong port. @rUse a number between 1 and 65535."
+	noDocker               = "@{!r}Container build files are only created for cl and web projects."
+	wrongDeploy            = "@{!r}Unsupported deployment target. @rOptions: heroku, k8s, systemd or fly."
+	noDeploy               = "@{!r}Deployment files are only created for web projects."
+	wrongCI                = "@{!r}Unsupported CI service. @rOptions: github, gitlab, drone or woodpecker."
+	ciWithoutEquals        = "@{!r}Wrong CI argument. @rGive the service with ´=´, as in ´--ci=gitlab´."
+	wrongGoVersion         = "@{!r}Wrong Go version. @rUse versions like ´1.22´ or ´1.22.1´."
+	noInterface            = "@{!r}You need to specify the interface of the plugin with ´--interface=<IMPORTPATH>.<NAME>´."
+	wrongInterface         = "@{!r}Wrong interface. @rUse ´<IMPORTPATH>.<NAME>´ (i.e. ´net/http.Handler´)."
+
+	// Conflict prompt
+	conflictQuestion = "@y File %s already exists. @{!y}[s]kip, [o]verwrite, [b]ackup or show [d]iff? "
+	conflictHelp     = "@{!y}Please answer s, o, b or d."
 
 	// Help messages
 	seeHelp = "@rSee ´gobi help´ for more info."
@@ -46,11 +61,47 @@ var (
 	helpCmd = `@bLooks like you need some help:
   @c- @{!y}gobi version@w: Shows current version.
   @c- @{!y}gobi whoami@w: Tells you who you are, so where are the projects going to be created.
-  @c- @{!y}gobi cl <APPNAME>@{!c}*@w: Creates a command line app ready to use.
-  @c- @{!y}gobi pkg <APPNAME>@{!c}*@w: Creates a Go package with a simple test suite and example.
+  @c- @{!y}gobi license list@w: Lists all supported licenses by their SPDX id.
+  @c- @{!y}gobi license show <LICENSE>@w: Shows the text of a license.
+  @c- @{!y}gobi license apply [DIR] [--license=<LICENSE>]@w: Adds or updates the license header of every Go file.
+  @c- @{!y}gobi licenses report [DIR] [--license=<LICENSE>] [--output=<FILE>]@w: Detects the licenses of the
+    dependencies, flags the ones incompatible with the project and writes a ´THIRD_PARTY_NOTICES´ file.
+  @c- @{!y}gobi cl <APPNAME>@{!c}*@w: Creates a command line app with subcommands, config, version and tests.
+  @c- @{!y}gobi pkg <APPNAME>@{!c}*@w: Creates a Go package with docs, tests, a benchmark, a fuzz test and examples.
   @c- @{!y}gobi web <APPNAME>@{!c}*@w: Creates a web application ready to deploy.
+  @c- @{!y}gobi api <APPNAME>@{!c}*@w: Creates a JSON REST API with an example resource and its OpenAPI spec.
+  @c- @{!y}gobi grpc <APPNAME>@{!c}*@w: Creates a gRPC service with its protobuf definition, a client and tests.
+  @c- @{!y}gobi worker <APPNAME>@{!c}*@w: Creates a background worker with a pool, a job source and graceful drain.
+  @c- @{!y}gobi repo <APPNAME>@{!c}*@w: Creates a repository with the ´cmd/´, ´internal/´ and ´pkg/´ layout.
+  @c- @{!y}gobi lib <APPNAME>@{!c}*@w: Creates a Go package with a command line tool that uses it.
+  @c- @{!y}gobi wasm <APPNAME>@{!c}*@w: Creates a WebAssembly program with its page and a development server.
+  @c- @{!y}gobi plugin <APPNAME>@{!c}*@{!y} --interface=<IMPORTPATH>.<NAME>@w: Creates a package implementing an
+    interface, with a stub of every method and tests.
 
   @{!c}* @{!y}<APPNAME> @|can have one or two levels and can't be empty. (Examples: ´regexp´, ´fmt´, ´net/http´, ´crypto/md5´)
+
+  @bOptions for all project types:
+  @c- @{!y}--on-conflict=skip|overwrite|prompt|backup@w: Regenerates an existing project. Existing files are
+    skipped, overwritten, asked for (showing a diff) or overwritten keeping a ´.orig´ backup.
+  @c- @{!y}--vcs=git|hg|fossil@w: Version control system of the project. (Default: the one of your host)
+  @c- @{!y}--init@w: Initializes a repository, commits the generated files and sets the remote.
+  @c- @{!y}--git@w: Shortcut for ´--vcs=git --init´.
+  @c- @{!y}--branch=<NAME>@w: Branch of the new repository. (Default: ´master´, ´default´ or ´trunk´)
+  @c- @{!y}--check@w: Runs the checks of the project type (gofmt, go vet, go build, go test) once created.
+  @c- @{!y}--license=<LICENSE>@w, @{!y}--host=<HOST>@w, @{!y}--id=<USERNAME>@w, @{!y}--author=<NAME [<EMAIL>]>@w:
+    Override your configuration for this project only.
+  @c- @{!y}--description=<TEXT>@w: Short description of the project, used on license notices.
+  @c- @{!y}--ci[=github|gitlab|drone|woodpecker]@w: Creates a pipeline running ´go vet´, ´go test -race´ and
+    ´go build´. (Default: the service of your host)
+  @c- @{!y}--go-versions=<VERSION>[,<VERSION>...]@w: Go versions the pipeline runs with. (Default: the two latest releases up to your Go)
+
+  @bOptions for cl and web projects:
+  @c- @{!y}--docker@w: Creates a multi-stage ´Dockerfile´ and a ´.dockerignore´, and a ´compose.yaml´ for web projects.
+  @c- @{!y}--port=<PORT>@w: Port a web application listens on. (Default: 5555)
+
+  @bOptions for web projects:
+  @c- @{!y}--deploy=heroku|k8s|systemd|fly@w: Creates the files to deploy it to a platform. Can be repeated, and
+    k8s and fly create the container build files too. (Default: heroku, a ´Procfile´ and a ´.godir´)
 `
 	// Prompted messages on user configuration form
 	promptForm = map[string]map[string]string{
@@ -72,14 +123,9 @@ var (
 			"welcome2": "@{!b}Email: "},
 		"license": map[string]string{
 			"welcome":  "@{!b}License: ",
-			"error":    c.Sprintf("@{!y}Invalid license, try again. @yOptions: %s", licenses.print()),
+			"error":    c.Sprintf("@{!y}Invalid license, try again. @yOptions: %s, or an expression like ´MIT OR Apache-2.0´", licenses.print()),
 			"welcome2": "@{!b}License: "},
 	}
-
-	// supported licenses
-	licenses = Licenses{"AGPL", "Apache", "BSD", "BSD3-Clause", "Eclipse",
-		"GPLv2", "GPLv3", "LGPLv2.1", "LGPLv3", "MIT",
-		"Mozilla", "PublicDomain", "WTFPL", "no-license"}
 )
 
 // welcome message and the logo
@@ -110,11 +156,99 @@ func fileExists(file string) {
 	c.Println("@y File", file, "already exists. Skipping.")
 }
 
+// fileNotCopied because its source can't be read
+func fileNotCopied(file, src string) {
+	c.Println("@y File", src, "not found. Skipping", file, "...")
+}
+
+// fileUnchanged because the new content is the same
+func fileUnchanged(file string) {
+	c.Println("@c File", file, "is up to date. Skipping.")
+}
+
+// fileOverwritten with the new content
+func fileOverwritten(file string) {
+	c.Println("@m Overwrite", file, "...")
+}
+
+// fileBackedUp before being overwritten
+func fileBackedUp(file, backup string) {
+	c.Println("@m Overwrite", file, "keeping a backup on", backup, "...")
+}
+
 // assetsCreated successfully
 func assetsCreated(file string) {
 	c.Println("@g Create assets on", file, "...")
 }
 
+// commandRun on the project directory
+func commandRun(name string, args []string) {
+	fmt.Println(c.Sprintf("@g Run %s %s ...", name, strings.Join(args, " ")))
+}
+
+// commandFailed prints the error of a command and exits the program
+func commandFailed(err error) {
+	fmt.Println(c.Sprintf("@{!r} Failed! @r%v", err))
+	os.Exit(1)
+}
+
+// stepFailed on the pipeline
+func stepFailed(step string, err error) {
+	fmt.Println(c.Sprintf("@{!r} Failed %s: @r%v", step, err))
+}
+
+// pipelineDone shows how many steps passed and which ones failed
+func pipelineDone(passed int, failed []string) {
+	if len(failed) == 0 {
+		fmt.Println(c.Sprintf("@{!g} Checks passed: %d", passed))
+		return
+	}
+	fmt.Println(c.Sprintf("@{!r} Checks passed: %d, failed: %d (%s)", passed, len(failed), strings.Join(failed, ", ")))
+}
+
+// headerApplied to a source file
+func headerApplied(file string) {
+	c.Println("@g Update license header of", file, "...")
+}
+
+// dependencyReported with its license and the problems found
+func dependencyReported(dep Dependency) {
+	license := dep.License
+	if license == "" {
+		license = "unknown"
+	}
+	if dep.Problem != "" {
+		fmt.Println(c.Sprintf("@y %s %s: @{!y}%s @r(%s)", dep.Path, dep.Version, license, dep.Problem))
+		return
+	}
+	fmt.Println(c.Sprintf("@g %s %s: @{!g}%s", dep.Path, dep.Version, license))
+}
+
+// hookRun from the manifest
+func hookRun(hook string) {
+	c.Println("@g Run hook", hook, "...")
+}
+
+// hookFailed prints the error of a hook
+func hookFailed(hook string, err error) {
+	fmt.Println(c.Sprintf("@{!r} Hook %s failed: @r%v", hook, err))
+}
+
+// rolledBack change on disk
+func rolledBack(path string) {
+	c.Println("@y Rollback", path, "...")
+}
+
+// repositoryExists message
+func repositoryExists(dir string) {
+	c.Println("@y Repository on", dir, "already exists. Skipping.")
+}
+
+// remoteAdded to the repository
+func remoteAdded(remote string) {
+	fmt.Println(c.Sprintf("@g Set origin remote to %s ...", remote))
+}
+
 // creadtionReady message
 func creationReady() {
 	c.Println("@{!g} Done!")
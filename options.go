@@ -0,0 +1,236 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Options contains the command line flags that change
+// the way a Project is created
+type Options struct {
+	OnConflict  string
+	VCS         string
+	Init        bool
+	Branch      string
+	Check       bool
+	Description string
+
+	// Interface implemented by a plugin, as ´<IMPORTPATH>.<NAME>´
+	Interface string
+	// Container build files and the port a web application listens on
+	Docker bool
+	Port   string
+	// Deployment targets of a web application
+	Deploy listFlag
+	// CI service and the Go versions its pipeline runs with
+	CI         ciFlag
+	GoVersions listFlag
+
+	// Overrides of the user configuration for this project
+	License string
+	Host    string
+	Id      string
+	Author  string
+}
+
+// listFlag is a flag that can be repeated, or given as a comma separated list
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*l = append(*l, v)
+		}
+	}
+	return nil
+}
+
+// author given as ´Name <email>´
+var authorWithEmail = regexp.MustCompile(`^(.*?)\s*<(.*)>$`)
+
+// Policies to apply when a file to create already exists
+const (
+	conflictSkip      = "skip"
+	conflictOverwrite = "overwrite"
+	conflictPrompt    = "prompt"
+	conflictBackup    = "backup"
+)
+
+// All supported conflict policies
+var conflictPolicies = []string{conflictSkip, conflictOverwrite, conflictPrompt, conflictBackup}
+
+// parseArgs returns the project name and the Options given
+// to a creation command. Flags can be placed before or after the name
+// If arguments are wrong the program is stopped
+func parseArgs(args []string) (name string, opts Options) {
+	fs := flag.NewFlagSet("gobi", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	fs.StringVar(&opts.OnConflict, "on-conflict", "", "")
+	fs.StringVar(&opts.VCS, "vcs", "", "")
+	fs.BoolVar(&opts.Init, "init", false, "")
+	git := fs.Bool("git", false, "")
+	fs.StringVar(&opts.Branch, "branch", "", "")
+	fs.BoolVar(&opts.Check, "check", false, "")
+	fs.StringVar(&opts.Description, "description", "", "")
+	fs.StringVar(&opts.Interface, "interface", "", "")
+	fs.BoolVar(&opts.Docker, "docker", false, "")
+	fs.StringVar(&opts.Port, "port", "", "")
+	fs.Var(&opts.Deploy, "deploy", "")
+	fs.Var(&opts.CI, "ci", "")
+	fs.Var(&opts.GoVersions, "go-versions", "")
+	fs.StringVar(&opts.License, "license", "", "")
+	fs.StringVar(&opts.Host, "host", "", "")
+	fs.StringVar(&opts.Id, "id", "", "")
+	fs.StringVar(&opts.Author, "author", "", "")
+
+	// A service given after --ci without ´=´ would be taken as a name
+	for i := 0; i+1 < len(args); i++ {
+		if (args[i] == "--ci" || args[i] == "-ci") && ciByName(args[i+1]) != nil {
+			commandLineError(ciWithoutEquals)
+		}
+	}
+
+	var names []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			commandLineError(wrongArgument)
+		}
+		if args = fs.Args(); len(args) == 0 {
+			break
+		}
+		names = append(names, args[0])
+		args = args[1:]
+	}
+
+	if l := len(names); l == 0 {
+		commandLineError(noProjectName)
+	} else if l > 1 {
+		commandLineError(wrongNumberOfArguments)
+	}
+	if opts.OnConflict != "" && !validateConflictPolicy(opts.OnConflict) {
+		commandLineError(wrongConflictPolicy)
+	}
+	opts.OnConflict = strings.ToLower(opts.OnConflict)
+
+	// --git is a shortcut for --vcs=git --init
+	if *git {
+		opts.VCS = vcsGit.Name
+		opts.Init = true
+	}
+	if opts.VCS != "" && !validateVCS(opts.VCS) {
+		commandLineError(wrongVCS)
+	}
+	if opts.Interface != "" && !validateInterface(opts.Interface) {
+		commandLineError(wrongInterface)
+	}
+	if opts.Port != "" && !validatePort(opts.Port) {
+		commandLineError(wrongPort)
+	}
+	for _, d := range opts.Deploy {
+		if !validateDeploy(d) {
+			commandLineError(wrongDeploy)
+		}
+	}
+	if opts.CI != "" && !validateCI(string(opts.CI)) {
+		commandLineError(wrongCI)
+	}
+	for _, v := range opts.GoVersions {
+		if !validateGoVersion(v) {
+			commandLineError(wrongGoVersion)
+		}
+	}
+	if opts.License != "" && !validateLicense(opts.License) {
+		commandLineError(wrongLicense)
+	}
+	if opts.Host != "" && !validateHost(opts.Host) {
+		commandLineError(wrongHost)
+	}
+	if opts.Id != "" && !validateUserName(opts.Id) {
+		commandLineError(wrongUserName)
+	}
+	if opts.Author != "" {
+		name, email := parseAuthor(opts.Author)
+		if !validateName(name) || (email != "" && !validateEmail(email)) {
+			commandLineError(wrongAuthor)
+		}
+	}
+	return names[0], opts
+}
+
+// parseDirArgs parses the flags of a command taking a directory, given
+// before or after them. It is the current directory if none is given
+func parseDirArgs(fs *flag.FlagSet, args []string) string {
+	var dirs []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			commandLineError(wrongArgument)
+		}
+		if args = fs.Args(); len(args) == 0 {
+			break
+		}
+		dirs = append(dirs, args[0])
+		args = args[1:]
+	}
+
+	switch len(dirs) {
+	case 0:
+		return "."
+	case 1:
+		return dirs[0]
+	}
+	commandLineError(wrongArgument)
+	return ""
+}
+
+// parseAuthor given as ´Name´ or ´Name <email>´
+func parseAuthor(author string) (name, email string) {
+	if m := authorWithEmail.FindStringSubmatch(author); m != nil {
+		return strings.TrimSpace(m[1]), strings.TrimSpace(m[2])
+	}
+	return strings.TrimSpace(author), ""
+}
+
+// Override the user configuration with the flags given,
+// so the stored configuration is not changed
+func (opts Options) Override(user UserConfig) UserConfig {
+	if opts.License != "" {
+		user.License = normalizeLicense(opts.License)
+	}
+	if opts.Host != "" {
+		user.Host = strings.ToLower(opts.Host)
+	}
+	if opts.Id != "" {
+		user.Id = opts.Id
+	}
+	if opts.Author != "" {
+		name, email := parseAuthor(opts.Author)
+		user.Name = name
+		if email != "" {
+			user.Email = email
+		}
+	}
+	return user
+}
+
+// validatePort: Must be a number between 1 and 65535
+func validatePort(port string) bool {
+	n, err := strconv.Atoi(port)
+	return err == nil && n > 0 && n <= 65535 && strconv.Itoa(n) == port
+}
+
+// validateConflictPolicy: Must be one of the supported policies
+func validateConflictPolicy(policy string) bool {
+	for _, p := range conflictPolicies {
+		if strings.EqualFold(policy, p) {
+			return true
+		}
+	}
+	return false
+}
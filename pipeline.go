@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// errUnexpectedOutput of a step that must not print anything
+var errUnexpectedOutput = errors.New("unexpected output")
+
+// Step is a command run on the project after it is created
+// If FailOnOutput is set, any output is considered a failure (i.e. ´gofmt -l´)
+// Steps run on the root directory, or on the project one if Dir is "project"
+type Step struct {
+	Run          string `json:"run"`
+	FailOnOutput bool   `json:"fail_on_output,omitempty"`
+	Dir          string `json:"dir,omitempty"`
+}
+
+// Step.Dir to run a step on the directory of the project
+const stepDirProject = "project"
+
+// Pipeline returns the steps to run after creating the Project
+// The user configuration for its type replaces the one of the manifest
+func (proj Project) Pipeline(user UserConfig) []Step {
+	if steps, ok := user.Pipeline[proj.Typ]; ok {
+		return steps
+	}
+	return proj.manifest.Pipeline
+}
+
+// RunPipeline on the root directory of the Project, streaming the output of
+// every step. All steps are run and the program exits if any of them fails
+func (proj Project) RunPipeline(steps []Step) {
+	var failed []string
+	for _, step := range steps {
+		dir := proj.RootDir()
+		if step.Dir == stepDirProject {
+			dir = proj.Dir()
+		}
+		if err := runStep(dir, step); err != nil {
+			stepFailed(step.Run, err)
+			failed = append(failed, step.Run)
+		}
+	}
+	pipelineDone(len(steps)-len(failed), failed)
+	if len(failed) > 0 {
+		os.Exit(1)
+	}
+}
+
+// goModuleMode of the go command on a directory. Projects created
+// on a GOPATH have no go.mod, so they are built on GOPATH mode
+// whatever the environment of the user is
+func goModuleMode(dir string) string {
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+		return "on"
+	}
+	return "off"
+}
+
+// runStep streaming its output
+func runStep(dir string, step Step) error {
+	args := strings.Fields(step.Run)
+	if len(args) == 0 {
+		return nil
+	}
+	commandRun(args[0], args[1:])
+	var out bytes.Buffer
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO111MODULE="+goModuleMode(dir))
+	cmd.Stdout = io.MultiWriter(os.Stdout, &out)
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return err
+	}
+	if step.FailOnOutput && out.Len() > 0 {
+		return errUnexpectedOutput
+	}
+	return nil
+}
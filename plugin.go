@@ -0,0 +1,232 @@
+package main
+
+import (
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Name of the type implementing the interface of a plugin
+const pluginImpl = "Plugin"
+
+// Packages imported by the generated files, whose names can't be
+// used by the packages of the interface
+var pluginImports = []string{"errors", "reflect", "testing"}
+
+// PluginStub is the implementation of an interface generated for a plugin
+// Interface is the interface as written on the plugin (i.e. ´io.Reader´)
+type PluginStub struct {
+	Path        string
+	Name        string
+	Impl        string
+	Interface   string
+	Imports     []string
+	TestImports []string
+	Methods     []string
+	MethodNames []string
+
+	// import names by path, to qualify the types of other packages
+	names   map[string]string
+	pkgName string
+}
+
+// splitInterface given as ´<IMPORTPATH>.<NAME>´ (i.e. ´net/http.Handler´)
+func splitInterface(spec string) (path, name string, ok bool) {
+	i := strings.LastIndex(spec, ".")
+	if i <= 0 || i == len(spec)-1 || strings.HasSuffix(spec[:i], "/") {
+		return "", "", false
+	}
+	return spec[:i], spec[i+1:], token.IsIdentifier(spec[i+1:])
+}
+
+// validateInterface: Must be an import path and an exported name
+func validateInterface(spec string) bool {
+	_, name, ok := splitInterface(spec)
+	return ok && token.IsExported(name)
+}
+
+// newPluginStub implementing the interface, whose methods are found
+// type checking the source of its package on GOROOT, GOPATH or the module
+func newPluginStub(spec, pkgName string) (*PluginStub, error) {
+	path, name, ok := splitInterface(spec)
+	if !ok {
+		return nil, fmt.Errorf("wrong interface %q", spec)
+	}
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(path)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a type of %s", name, path)
+	}
+	if named, ok := obj.Type().(*types.Named); ok && named.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("%s is generic, which is not supported", spec)
+	}
+	iface, ok := obj.Type().Underlying().(*types.Interface)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an interface", spec)
+	}
+
+	stub := &PluginStub{Path: path, Name: name, Impl: pluginImpl, names: make(map[string]string), pkgName: pkgName}
+	stub.Interface = stub.qualifier(pkg) + "." + name
+	stub.TestImports = importSpecs(map[string]string{path: stub.names[path]},
+		pluginImports...)
+	for i := 0; i < iface.NumMethods(); i++ {
+		m := iface.Method(i)
+		if !m.Exported() {
+			return nil, fmt.Errorf("%s has the unexported method %s, so it can't be implemented", spec, m.Name())
+		}
+		stub.Methods = append(stub.Methods, stub.method(m))
+		stub.MethodNames = append(stub.MethodNames, m.Name())
+	}
+	stub.Imports = importSpecs(stub.names, "errors")
+	return stub, nil
+}
+
+// importSpecs of the packages by their names, sorted by path as gofmt does.
+// Packages whose name is not the last element of their path are renamed
+func importSpecs(names map[string]string, std ...string) []string {
+	paths := append([]string(nil), std...)
+	for p := range names {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	var specs []string
+	for i, p := range paths {
+		if i > 0 && p == paths[i-1] {
+			continue
+		}
+		n, ok := names[p]
+		if !ok || n == p[strings.LastIndex(p, "/")+1:] {
+			specs = append(specs, strconv.Quote(p))
+			continue
+		}
+		specs = append(specs, n+" "+strconv.Quote(p))
+	}
+	return specs
+}
+
+// qualifier returns the name a package is imported with, choosing a new
+// one if its name is already used by another package
+func (stub *PluginStub) qualifier(pkg *types.Package) string {
+	if n, ok := stub.names[pkg.Path()]; ok {
+		return n
+	}
+	name := pkg.Name()
+	for i := 2; stub.nameUsed(name); i++ {
+		name = pkg.Name() + strconv.Itoa(i)
+	}
+	stub.names[pkg.Path()] = name
+	return name
+}
+
+// nameUsed by an import or the package of the plugin
+func (stub *PluginStub) nameUsed(name string) bool {
+	if name == stub.pkgName {
+		return true
+	}
+	for _, n := range pluginImports {
+		if n == name {
+			return true
+		}
+	}
+	for _, n := range stub.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// method returns the source of the stub of an interface method. Results are
+// named so the error ones are set to errNotImplemented and the rest are zero
+func (stub *PluginStub) method(m *types.Func) string {
+	sig := m.Type().(*types.Signature)
+	used := make(map[string]bool)
+	for _, t := range []*types.Tuple{sig.Params(), sig.Results()} {
+		for i := 0; i < t.Len(); i++ {
+			used[t.At(i).Name()] = true
+		}
+	}
+	recv := receiverName(used)
+	used[recv] = true
+
+	params := make([]string, sig.Params().Len())
+	for i := range params {
+		v := sig.Params().At(i)
+		typ := types.TypeString(v.Type(), stub.qualifier)
+		if sig.Variadic() && i == len(params)-1 {
+			typ = "..." + types.TypeString(v.Type().(*types.Slice).Elem(), stub.qualifier)
+		}
+		params[i] = paramName(v.Name()) + " " + typ
+	}
+
+	results := make([]string, sig.Results().Len())
+	var errResults []string
+	for i := range results {
+		v := sig.Results().At(i)
+		name := paramName(v.Name())
+		if isError(v.Type()) {
+			if name == "_" {
+				name = "err"
+				for n := 2; used[name]; n++ {
+					name = "err" + strconv.Itoa(n)
+				}
+				used[name] = true
+			}
+			errResults = append(errResults, name)
+		}
+		results[i] = name + " " + types.TypeString(v.Type(), stub.qualifier)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// %s is not implemented yet\n", m.Name())
+	fmt.Fprintf(&b, "func (%s *%s) %s(%s)", recv, pluginImpl, m.Name(), strings.Join(params, ", "))
+	if len(results) > 0 {
+		fmt.Fprintf(&b, " (%s)", strings.Join(results, ", "))
+	}
+	fmt.Fprintf(&b, " {\n\t// TODO: implement %s\n", m.Name())
+	for _, name := range errResults {
+		fmt.Fprintf(&b, "\t%s = errNotImplemented\n", name)
+	}
+	if len(results) > 0 {
+		b.WriteString("\treturn\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// receiverName returns the first letter of the implementation, or the
+// next letter not used by the parameters and results of the method
+func receiverName(used map[string]bool) string {
+	first := int(strings.ToLower(pluginImpl)[0] - 'a')
+	for i := 0; i < 26; i++ {
+		if name := string(rune('a' + (first+i)%26)); !used[name] {
+			return name
+		}
+	}
+	name := strings.ToLower(pluginImpl)
+	for n := 2; used[name]; n++ {
+		name = strings.ToLower(pluginImpl) + strconv.Itoa(n)
+	}
+	return name
+}
+
+// paramName returns the name of a parameter, or ´_´ if it has none
+func paramName(name string) string {
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// isError returns true for the error type
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
@@ -1,40 +1,134 @@
 package main
 
 import (
+	"bytes"
+	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"text/template"
+	"time"
+
+	c "github.com/wsxiaoys/terminal/color"
 )
 
+// Project types with container build files
+var containerTypes = []string{"cl", "web"}
+
+// Port a web application listens on by default
+const defaultPort = "5555"
+
+// Static assets vendored on templates/web/static and
+// copied verbatim, as they aren't templates
+var webAssets = []string{
+	filepath.Join("css", "bootstrap.min.css"),
+	filepath.Join("js", "bootstrap.min.js"),
+	filepath.Join("js", "jquery.min.js"),
+}
+
+// All supported project types
+var projectTypes = []string{"cl", "pkg", "web", "api", "grpc", "worker", "repo", "lib", "wasm", "plugin"}
+
+// Default description of every project type
+var descriptions = map[string]string{
+	"cl":     "A command line tool written in Go",
+	"pkg":    "A package written in Go",
+	"web":    "A web application written in Go",
+	"api":    "A JSON REST API written in Go",
+	"grpc":   "A gRPC service written in Go",
+	"worker": "A background worker written in Go",
+	"repo":   "A repository of Go commands and packages",
+	"lib":    "A package written in Go with a command line tool",
+	"wasm":   "A WebAssembly program written in Go",
+	"plugin": "A plugin written in Go",
+}
+
 // Project contains all the information
 // of an application created by gobi
 type Project struct {
-	Name       string
-	FirstName  string
-	SecondName string
-	GoGetName  string
-	UserId     string
-	UserName   string
-	UserEmail  string
-	Host       string
-	License    string
-	Typ        string
-}
-
-// NewProject creates the application from the name, type
-// and the user configuration
-func NewProject(name, typ string, user UserConfig) *Project {
+	Name        string
+	FirstName   string
+	SecondName  string
+	GoGetName   string
+	UserId      string
+	UserName    string
+	UserEmail   string
+	Host        string
+	License     string
+	Typ         string
+	Description string
+	Year        int
+	Port        string
+	Opts        Options
+	// Vars added by the pre hooks of the manifest
+	Vars map[string]string
+	// Stub of the interface implemented by a plugin
+	Stub *PluginStub
+
+	manifest Manifest
+	journal  *journal
+}
+
+// NewProject creates the application from the name, type,
+// the user configuration and the command line options
+func NewProject(name, typ string, user UserConfig, opts Options) *Project {
 	firstName, secondName := ValidateName(name)
 	goGetName := GoGetName(user.Host, user.Id, name)
-	return &Project{name, firstName, secondName, goGetName, user.Id, user.Name, user.Email, user.Host, user.License, typ}
+	description := opts.Description
+	if description == "" {
+		description = descriptions[typ]
+	}
+	port := opts.Port
+	if port == "" {
+		port = defaultPort
+	}
+	return &Project{
+		Name:        name,
+		FirstName:   firstName,
+		SecondName:  secondName,
+		GoGetName:   goGetName,
+		UserId:      user.Id,
+		UserName:    user.Name,
+		UserEmail:   user.Email,
+		Host:        user.Host,
+		License:     user.License,
+		Typ:         typ,
+		Description: description,
+		Year:        time.Now().Year(),
+		Port:        port,
+		Opts:        opts,
+		Vars:        make(map[string]string),
+		manifest:    loadManifest(typ),
+		journal:     &journal{},
+	}
+}
+
+// isProjectType returns true if typ is a supported project type
+func isProjectType(typ string) bool {
+	for _, t := range projectTypes {
+		if typ == t {
+			return true
+		}
+	}
+	return false
 }
 
 // Create the project distinguishing on the type
-func (proj Project) Create() {
-	if proj.Exists() {
+// An existing project is only regenerated if a conflict policy is given
+// The hooks of the manifest run before and after the files are written
+func (proj *Project) Create() {
+	if proj.Exists() && proj.Opts.OnConflict == "" {
 		commandLineError(projectExists)
 	}
+	if proj.Opts.Docker && !proj.HasContainer() {
+		commandLineError(noDocker)
+	}
+	if len(proj.Opts.Deploy) > 0 && proj.Typ != "web" {
+		commandLineError(noDeploy)
+	}
+	proj.RunPreHooks()
 	switch typ := proj.Typ; typ {
 	// Command line app
 	case "cl":
@@ -45,93 +139,312 @@ func (proj Project) Create() {
 	// Web application
 	case "web":
 		proj.Web()
+	// JSON REST API
+	case "api":
+		proj.Api()
+	// gRPC service
+	case "grpc":
+		proj.Grpc()
+	// Background worker
+	case "worker":
+		proj.Worker()
+	// Repository of commands and packages
+	case "repo":
+		proj.Repo()
+	// Package with a command
+	case "lib":
+		proj.Lib()
+	// WebAssembly program
+	case "wasm":
+		proj.Wasm()
+	// Plugin implementing an interface
+	case "plugin":
+		proj.Plugin()
+	}
+	proj.CreateCIFiles()
+	proj.RunPostHooks()
+	if proj.Opts.Init {
+		proj.InitRepository()
 	}
 	creationReady()
 }
 
 // Cl creates the command line application based on a Project
 func (proj Project) Cl() {
-	// buildDir may vary change if GOOGLE is the host
-	var buildDir, buildDirFirst string
-	if proj.Host == GOOGLE {
-		buildDir = filepath.Join(SRCPATH, proj.Host, "p", proj.Name)
-		buildDirFirst = filepath.Join(SRCPATH, proj.Host, "p", proj.FirstName)
-	} else {
-		buildDir = filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.Name)
-		buildDirFirst = filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.FirstName)
-	}
+	buildDir := proj.Dir()
 	// Create build directory and necessary files
-	os.MkdirAll(buildDir, 0744)
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "AUTHORS"), "AUTHORS.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "VERSION"), "VERSION.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, ".gitignore"), "gitignore.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "LICENSE"),
-		filepath.Join("license", proj.License+".tpl"))
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "README.md"),
-		filepath.Join(proj.Typ, "README.md.tpl"))
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
 	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
 		filepath.Join(proj.Typ, "proj.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+"_test.go"),
+		filepath.Join(proj.Typ, "proj_test.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "Makefile"),
+		filepath.Join(proj.Typ, "Makefile.tpl"))
+	if proj.Opts.Docker {
+		proj.CreateContainerFiles()
+	}
 }
 
 // Pkg creates a Go package based on a Project
 func (proj Project) Pkg() {
-	// buildDir may vary change if GOOGLE is the host
-	var buildDir, buildDirFirst string
-	if proj.Host == GOOGLE {
-		buildDir = filepath.Join(SRCPATH, proj.Host, "p", proj.Name)
-		buildDirFirst = filepath.Join(SRCPATH, proj.Host, "p", proj.FirstName)
-	} else {
-		buildDir = filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.Name)
-		buildDirFirst = filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.FirstName)
-	}
+	buildDir := proj.Dir()
 	// Create build directory and necessary files
-	// For a package a test and example are created
-	os.MkdirAll(buildDir, 0744)
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "AUTHORS"), "AUTHORS.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "VERSION"), "VERSION.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, ".gitignore"), "gitignore.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "LICENSE"),
-		filepath.Join("license", proj.License+".tpl"))
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "README.md"),
-		filepath.Join(proj.Typ, "README.md.tpl"))
+	// For a package its documentation, tests, benchmark,
+	// fuzz test and runnable examples are created
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "doc.go"),
+		filepath.Join(proj.Typ, "doc.go.tpl"))
 	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
 		filepath.Join(proj.Typ, "proj.go.tpl"))
 	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+"_test.go"),
 		filepath.Join(proj.Typ, "proj_test.go.tpl"))
-	os.MkdirAll(filepath.Join(buildDirFirst, "examples"), 0744)
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "examples", proj.SecondName+"_example.go"),
-		filepath.Join(proj.Typ, "example.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "example_test.go"),
+		filepath.Join(proj.Typ, "example_test.go.tpl"))
 }
 
 // Web creates a web application based on a Project
 func (proj Project) Web() {
-	// buildDir may vary change if GOOGLE is the host
-	var buildDir, buildDirFirst string
-	if proj.Host == GOOGLE {
-		buildDir = filepath.Join(SRCPATH, proj.Host, "p", proj.Name)
-		buildDirFirst = filepath.Join(SRCPATH, proj.Host, "p", proj.FirstName)
-	} else {
-		buildDir = filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.Name)
-		buildDirFirst = filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.FirstName)
-	}
+	buildDir := proj.Dir()
 	// Create build directory and necessary files
 	// For a web application deployment files and static assets are created
-	os.MkdirAll(buildDir, 0744)
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "AUTHORS"), "AUTHORS.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "VERSION"), "VERSION.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, ".gitignore"), "gitignore.tpl")
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "LICENSE"),
-		filepath.Join("license", proj.License+".tpl"))
-	proj.CreateFileFromTemplate(filepath.Join(buildDirFirst, "README.md"),
-		filepath.Join(proj.Typ, "README.md.tpl"))
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
+		filepath.Join(proj.Typ, "proj.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+"_test.go"),
+		filepath.Join(proj.Typ, "proj_test.go.tpl"))
+	// Templates and static assets are embedded in the binary
+	proj.journal.mkdirAll(filepath.Join(buildDir, "templates"))
+	proj.journal.mkdirAll(filepath.Join(buildDir, "static", "css"))
+	proj.journal.mkdirAll(filepath.Join(buildDir, "static", "js"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "templates", "index.html"),
+		filepath.Join(proj.Typ, "index.html.tpl"))
+	// Bootstrap and jQuery are vendored, so the site needs no CDN
+	for _, asset := range webAssets {
+		proj.CopyFile(filepath.Join(buildDir, "static", asset),
+			filepath.Join(GOBIPATH, "templates", proj.Typ, "static", asset))
+	}
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "static", "css", "app.css"),
+		filepath.Join(proj.Typ, "app.css.tpl"))
+	if proj.Opts.Docker || proj.HasContainerDeploy() {
+		proj.CreateContainerFiles()
+		proj.CreateFileFromTemplate(filepath.Join(buildDir, "compose.yaml"),
+			filepath.Join("docker", "compose.yaml.tpl"))
+	}
+	proj.CreateDeployFiles()
+}
+
+// Api creates a JSON REST API based on a Project
+func (proj Project) Api() {
+	buildDir := proj.Dir()
+	// Create build directory and necessary files
+	// For an API the router, JSON helpers, an example
+	// resource and its OpenAPI description are created
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
 	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
 		filepath.Join(proj.Typ, "proj.go.tpl"))
-	proj.CreateFileFromTemplate(filepath.Join(buildDir, ".godir"),
-		filepath.Join(proj.Typ, "godir.tpl"))
-	proj.CreateFileFromTemplate(filepath.Join(buildDir, "Procfile"),
-		filepath.Join(proj.Typ, "Procfile.tpl"))
-	proj.CreateFileFromTemplate(filepath.Join(buildDir, "index.html"),
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "routes.go"),
+		filepath.Join(proj.Typ, "routes.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "json.go"),
+		filepath.Join(proj.Typ, "json.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "items.go"),
+		filepath.Join(proj.Typ, "items.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+"_test.go"),
+		filepath.Join(proj.Typ, "proj_test.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "openapi.yaml"),
+		filepath.Join(proj.Typ, "openapi.yaml.tpl"))
+}
+
+// Grpc creates a gRPC service based on a Project
+func (proj Project) Grpc() {
+	buildDir := proj.Dir()
+	// Create build directory and necessary files
+	// For a gRPC service the protobuf definition, the server, a client
+	// example and a Makefile to generate the Go code are created.
+	// It is a module, as gRPC can't be used without one
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "go.mod"),
+		filepath.Join(proj.Typ, "go.mod.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "Makefile"),
+		filepath.Join(proj.Typ, "Makefile.tpl"))
+	proj.journal.mkdirAll(filepath.Join(buildDir, "proto", "greeter", "v1"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "proto", "greeter", "v1", "greeter.proto"),
+		filepath.Join(proj.Typ, "greeter.proto.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
+		filepath.Join(proj.Typ, "proj.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "server.go"),
+		filepath.Join(proj.Typ, "server.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "server_test.go"),
+		filepath.Join(proj.Typ, "server_test.go.tpl"))
+	proj.journal.mkdirAll(filepath.Join(buildDir, "client"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "client", "main.go"),
+		filepath.Join(proj.Typ, "client.go.tpl"))
+}
+
+// Worker creates a background worker based on a Project
+func (proj Project) Worker() {
+	buildDir := proj.Dir()
+	// Create build directory and necessary files
+	// For a worker the pool, the job source and their tests are created
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
+		filepath.Join(proj.Typ, "proj.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "pool.go"),
+		filepath.Join(proj.Typ, "pool.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "source.go"),
+		filepath.Join(proj.Typ, "source.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "pool_test.go"),
+		filepath.Join(proj.Typ, "pool_test.go.tpl"))
+}
+
+// Repo creates a repository of commands and packages based on a Project,
+// with the cmd/, internal/ and pkg/ layout
+func (proj Project) Repo() {
+	buildDir := proj.Dir()
+	// Create build directory and necessary files
+	// For a repository one command using one internal
+	// and one public package, and a Makefile are created
+	cmdDir := filepath.Join(buildDir, "cmd", proj.SecondName)
+	internalDir := filepath.Join(buildDir, "internal", "greeter")
+	pkgDir := filepath.Join(buildDir, "pkg", "version")
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "Makefile"),
+		filepath.Join(proj.Typ, "Makefile.tpl"))
+	proj.journal.mkdirAll(cmdDir)
+	proj.CreateFileFromTemplate(filepath.Join(cmdDir, "main.go"),
+		filepath.Join(proj.Typ, "main.go.tpl"))
+	proj.journal.mkdirAll(internalDir)
+	proj.CreateFileFromTemplate(filepath.Join(internalDir, "greeter.go"),
+		filepath.Join(proj.Typ, "greeter.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(internalDir, "greeter_test.go"),
+		filepath.Join(proj.Typ, "greeter_test.go.tpl"))
+	proj.journal.mkdirAll(pkgDir)
+	proj.CreateFileFromTemplate(filepath.Join(pkgDir, "version.go"),
+		filepath.Join(proj.Typ, "version.go.tpl"))
+}
+
+// Lib creates a Go package with a command line tool based on a Project
+// The package files are the ones of Pkg, and the command imports them
+func (proj Project) Lib() {
+	buildDir := proj.Dir()
+	cmdDir := filepath.Join(buildDir, "cmd", proj.SecondName)
+	// Create build directory and necessary files
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "doc.go"),
+		filepath.Join("pkg", "doc.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
+		filepath.Join("pkg", "proj.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+"_test.go"),
+		filepath.Join("pkg", "proj_test.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "example_test.go"),
+		filepath.Join("pkg", "example_test.go.tpl"))
+	proj.journal.mkdirAll(cmdDir)
+	proj.CreateFileFromTemplate(filepath.Join(cmdDir, "main.go"),
+		filepath.Join(proj.Typ, "main.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(cmdDir, "main_test.go"),
+		filepath.Join(proj.Typ, "main_test.go.tpl"))
+}
+
+// Wasm creates a WebAssembly program based on a Project
+func (proj Project) Wasm() {
+	buildDir := proj.Dir()
+	webDir := filepath.Join(buildDir, "web")
+	// Create build directory and necessary files
+	// For a WebAssembly program the page loading it, the wasm_exec.js
+	// of the local Go installation and a development server are created
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "main.go"),
+		filepath.Join(proj.Typ, "main.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "main_other.go"),
+		filepath.Join(proj.Typ, "main_other.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "greet.go"),
+		filepath.Join(proj.Typ, "greet.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "greet_test.go"),
+		filepath.Join(proj.Typ, "greet_test.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "Makefile"),
+		filepath.Join(proj.Typ, "Makefile.tpl"))
+	proj.journal.mkdirAll(webDir)
+	proj.CreateFileFromTemplate(filepath.Join(webDir, "index.html"),
 		filepath.Join(proj.Typ, "index.html.tpl"))
+	proj.CopyFile(filepath.Join(webDir, "wasm_exec.js"), wasmExecPath())
+	proj.journal.mkdirAll(filepath.Join(buildDir, "serve"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "serve", "main.go"),
+		filepath.Join(proj.Typ, "serve.go.tpl"))
+}
+
+// Plugin creates a package implementing the interface given with
+// --interface based on a Project. A stub of every method is generated
+func (proj Project) Plugin() {
+	if proj.Opts.Interface == "" {
+		commandLineError(noInterface)
+	}
+	stub, err := newPluginStub(proj.Opts.Interface, proj.SecondName)
+	if err != nil {
+		commandFailed(err)
+	}
+	proj.Stub = stub
+
+	buildDir := proj.Dir()
+	// Create build directory and necessary files
+	proj.journal.mkdirAll(buildDir)
+	proj.CreateRootFiles()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+".go"),
+		filepath.Join(proj.Typ, "proj.go.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, proj.SecondName+"_test.go"),
+		filepath.Join(proj.Typ, "proj_test.go.tpl"))
+}
+
+// wasmExecPath returns the path of the wasm_exec.js of the local Go
+// installation. It was moved from misc/wasm to lib/wasm on Go 1.24
+func wasmExecPath() string {
+	out, _ := exec.Command("go", "env", "GOROOT").Output()
+	goroot := strings.TrimSpace(string(out))
+	path := filepath.Join(goroot, "lib", "wasm", "wasm_exec.js")
+	if _, err := os.Stat(path); err != nil {
+		return filepath.Join(goroot, "misc", "wasm", "wasm_exec.js")
+	}
+	return path
+}
+
+// CreateRootFiles of the Project: AUTHORS, VERSION, ignore,
+// license and README files, common to all project types
+func (proj Project) CreateRootFiles() {
+	rootDir := proj.RootDir()
+	proj.CreateFileFromTemplate(filepath.Join(rootDir, "AUTHORS"), "AUTHORS.tpl")
+	proj.CreateFileFromTemplate(filepath.Join(rootDir, "VERSION"), "VERSION.tpl")
+	proj.CreateFileFromTemplate(filepath.Join(rootDir, proj.VCS().IgnoreFile), proj.VCS().IgnoreTemplate)
+	proj.CreateLicenseFiles(rootDir)
+	proj.CreateFileFromTemplate(filepath.Join(rootDir, "README.md"),
+		filepath.Join(proj.Typ, "README.md.tpl"))
+}
+
+// HasContainer returns true if container build files
+// can be created for the type of the Project
+func (proj Project) HasContainer() bool {
+	for _, t := range containerTypes {
+		if proj.Typ == t {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateContainerFiles to build an image of the Project,
+// whose build context is the directory of the project
+func (proj Project) CreateContainerFiles() {
+	buildDir := proj.Dir()
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, "Dockerfile"),
+		filepath.Join("docker", "Dockerfile.tpl"))
+	proj.CreateFileFromTemplate(filepath.Join(buildDir, ".dockerignore"),
+		filepath.Join("docker", "dockerignore.tpl"))
 }
 
 // Exists returns true if the Project already exists
@@ -145,16 +458,131 @@ func (proj Project) Exists() bool {
 	return err == nil
 }
 
+// RootPath returns the relative path from the directory
+// of the Project to its root directory
+func (proj Project) RootPath() string {
+	rel, _ := filepath.Rel(proj.Dir(), proj.RootDir())
+	return rel
+}
+
+// CreateLicenseFiles of the Project on a directory
+func (proj Project) CreateLicenseFiles(dir string) {
+	for _, lf := range licenseFiles(proj.License) {
+		proj.CreateFileFromTemplate(filepath.Join(dir, lf.Name), filepath.Join("license", lf.Template))
+	}
+}
+
+// LicenseFileNames returns the names of the license files, as used on README
+func (proj Project) LicenseFileNames() string {
+	files := licenseFiles(proj.License)
+	names := make([]string, len(files))
+	for i, lf := range files {
+		names[i] = lf.Name
+	}
+	if l := len(names); l > 1 {
+		return strings.Join(names[:l-1], ", ") + " and " + names[l-1]
+	}
+	return strings.Join(names, "")
+}
+
+// Dir returns the directory of the Project
+func (proj Project) Dir() string {
+	if proj.Host == GOOGLE {
+		return filepath.Join(SRCPATH, proj.Host, "p", proj.Name)
+	}
+	return filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.Name)
+}
+
+// RootDir returns the directory where the root files
+// of the Project (LICENSE, README, ...) are located
+func (proj Project) RootDir() string {
+	if proj.Host == GOOGLE {
+		return filepath.Join(SRCPATH, proj.Host, "p", proj.FirstName)
+	}
+	return filepath.Join(SRCPATH, proj.Host, proj.UserId, proj.FirstName)
+}
+
 // CreateFileFromTemplate if this file does not exist yet
+// If it exists, the conflict policy of the Project decides what to do
 func (proj Project) CreateFileFromTemplate(file, temp string) {
 	tempfile := filepath.Join(GOBIPATH, "templates", temp)
+	t, _ := template.ParseFiles(tempfile)
+	var buf bytes.Buffer
+	// Go source files start with the license header
+	if strings.HasSuffix(file, ".go") {
+		buf.WriteString(proj.Header())
+	}
+	t.Execute(&buf, proj)
+	proj.createFile(file, buf.Bytes())
+}
+
+// CopyFile as it is, without rendering it as a template
+// If src can't be read the file is not created
+func (proj Project) CopyFile(file, src string) {
+	content, err := ioutil.ReadFile(src)
+	if err != nil {
+		fileNotCopied(file, src)
+		return
+	}
+	proj.createFile(file, content)
+}
+
+// createFile with its content if it does not exist yet
+// If it exists, the conflict policy of the Project decides what to do
+func (proj Project) createFile(file string, content []byte) {
 	if _, err := os.Stat(file); os.IsNotExist(err) {
-		t, _ := template.ParseFiles(tempfile)
-		f, _ := os.Create(file)
-		t.Execute(f, proj)
+		proj.journal.writeFile(file, content)
 		fileCreated(file)
-	} else {
+		return
+	}
+	old, err := ioutil.ReadFile(file)
+	if err != nil {
 		fileExists(file)
+		return
+	}
+	if bytes.Equal(old, content) {
+		fileUnchanged(file)
+		return
+	}
+
+	policy := proj.Opts.OnConflict
+	if policy == conflictPrompt {
+		policy = askConflict(file, string(old), string(content))
+	}
+	switch policy {
+	case conflictOverwrite:
+		proj.journal.writeFile(file, content)
+		fileOverwritten(file)
+	case conflictBackup:
+		proj.journal.writeFile(file+".orig", old)
+		proj.journal.writeFile(file, content)
+		fileBackedUp(file, file+".orig")
+	default:
+		fileExists(file)
+	}
+}
+
+// askConflict prompts the user what to do with a file that already
+// exists and differs from the new one. The diff can be shown before answering
+func askConflict(file, oldText, newText string) string {
+	for {
+		c.Printf(conflictQuestion, file)
+		resp, err := stdin.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(resp)) {
+		case "o", "overwrite":
+			return conflictOverwrite
+		case "b", "backup":
+			return conflictBackup
+		case "d", "diff":
+			printDiff(file, oldText, newText)
+		case "s", "skip", "":
+			return conflictSkip
+		default:
+			c.Println(conflictHelp)
+		}
+		if err != nil {
+			return conflictSkip
+		}
 	}
 }
 
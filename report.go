@@ -0,0 +1,376 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Minimum similarity between a license file and a
+// license template to consider them the same license
+const licenseSimilarity = 0.8
+
+// Dependency of a project and the license found for it
+type Dependency struct {
+	Path    string
+	Version string
+	Dir     string
+	File    string
+	License string
+	Problem string
+}
+
+// Kinds of licenses, from the least to the most restrictive
+const (
+	permissive = iota
+	weakCopyleft
+	strongCopyleft
+	networkCopyleft
+)
+
+// licenseKinds by SPDX id. Missing licenses are permissive
+var licenseKinds = map[string]int{
+	"LGPL-2.1-only":     weakCopyleft,
+	"LGPL-2.1-or-later": weakCopyleft,
+	"LGPL-3.0-only":     weakCopyleft,
+	"LGPL-3.0-or-later": weakCopyleft,
+	"MPL-2.0":           weakCopyleft,
+	"EPL-1.0":           weakCopyleft,
+	"GPL-2.0-only":      strongCopyleft,
+	"GPL-2.0-or-later":  strongCopyleft,
+	"GPL-3.0-only":      strongCopyleft,
+	"GPL-3.0-or-later":  strongCopyleft,
+	"AGPL-3.0-only":     networkCopyleft,
+	"AGPL-3.0-or-later": networkCopyleft,
+}
+
+// Licenses that can't be combined with any GNU license
+var gnuIncompatible = map[string]bool{
+	"EPL-1.0": true,
+}
+
+// SPDX license ids written on source files
+var spdxIdentifier = regexp.MustCompile(`SPDX-License-Identifier:\s*([A-Za-z0-9.+-]+)`)
+
+// Names of license files, ignoring the case
+var licenseFileName = regexp.MustCompile(`(?i)^(LICEN[CS]E|COPYING|UNLICENSE)([-._].*)?$`)
+
+// Text of Go templates, ignored when comparing licenses
+var templateAction = regexp.MustCompile(`{{[^}]*}}`)
+
+// reportLicenses of the dependencies of a project, as ´gobi licenses report´ does.
+// They are read from go.mod or Godeps/Godeps.json, and their licenses are
+// detected comparing them with the license templates of gobi
+func reportLicenses(args []string, user UserConfig) {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	license := fs.String("license", user.License, "")
+	output := fs.String("output", "THIRD_PARTY_NOTICES", "")
+	dir := parseDirArgs(fs, args)
+	if !validateLicense(*license) {
+		commandLineError(wrongLicense)
+	}
+
+	deps, err := readDependencies(dir)
+	if err != nil {
+		commandLineError(noDependencies)
+	}
+	_, projLicenses, _ := parseLicenseExpression(*license)
+	for i := range deps {
+		deps[i].detectLicense(dir)
+		deps[i].checkCompatibility(projLicenses)
+		dependencyReported(deps[i])
+	}
+
+	notices := filepath.Join(dir, *output)
+	if err := ioutil.WriteFile(notices, []byte(thirdPartyNotices(deps)), 0644); err != nil {
+		commandFailed(err)
+	}
+	fileCreated(notices)
+}
+
+// readDependencies of a project from its go.mod or Godeps/Godeps.json
+func readDependencies(dir string) ([]Dependency, error) {
+	if f, err := os.Open(filepath.Join(dir, "go.mod")); err == nil {
+		defer f.Close()
+		return parseGoMod(f), nil
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "Godeps", "Godeps.json"))
+	if err != nil {
+		return nil, err
+	}
+	var godeps struct {
+		Deps []struct {
+			ImportPath string
+			Comment    string
+			Rev        string
+		}
+	}
+	if err := json.Unmarshal(b, &godeps); err != nil {
+		return nil, err
+	}
+	deps := make([]Dependency, len(godeps.Deps))
+	for i, d := range godeps.Deps {
+		deps[i] = Dependency{Path: d.ImportPath, Version: d.Rev}
+	}
+	return deps, nil
+}
+
+// parseGoMod returns the required modules of a go.mod file
+func parseGoMod(f io.Reader) []Dependency {
+	var deps []Dependency
+	inRequire := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+		case inRequire && fields[0] == ")":
+			inRequire = false
+		case inRequire && len(fields) == 2:
+			deps = append(deps, Dependency{Path: fields[0], Version: fields[1]})
+		case fields[0] == "require" && len(fields) == 2 && fields[1] == "(":
+			inRequire = true
+		case fields[0] == "require" && len(fields) == 3:
+			deps = append(deps, Dependency{Path: fields[1], Version: fields[2]})
+		}
+	}
+	return deps
+}
+
+// sourceDirs where the code of a dependency can be found: vendored
+// on the project, on the module cache or on GOPATH
+func (dep Dependency) sourceDirs(projDir string) []string {
+	modCache := os.Getenv("GOMODCACHE")
+	if modCache == "" {
+		modCache = filepath.Join(GOPATH, "pkg", "mod")
+	}
+	return []string{
+		filepath.Join(projDir, "vendor", dep.Path),
+		filepath.Join(projDir, "Godeps", "_workspace", "src", dep.Path),
+		filepath.Join(modCache, escapeModulePath(dep.Path)+"@"+dep.Version),
+		filepath.Join(SRCPATH, dep.Path),
+	}
+}
+
+// escapeModulePath as the module cache does, writing
+// upper case letters as ´!´ followed by the lower case letter
+func escapeModulePath(path string) string {
+	var b strings.Builder
+	for _, r := range path {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// detectLicense of the dependency looking for a license file on its
+// source or its parent directories, as subpackages usually have none
+func (dep *Dependency) detectLicense(projDir string) {
+	for _, dir := range dep.sourceDirs(projDir) {
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		dep.Dir = dir
+		for d, levels := dir, strings.Count(dep.Path, "/"); levels >= 0; d, levels = filepath.Dir(d), levels-1 {
+			if file := findLicenseFile(d); file != "" {
+				dep.File = file
+				b, _ := ioutil.ReadFile(file)
+				dep.License = dep.chooseLicense(matchLicense(string(b)))
+				return
+			}
+		}
+		return
+	}
+}
+
+// findLicenseFile on a directory, or empty if there is none
+func findLicenseFile(dir string) string {
+	files, _ := ioutil.ReadDir(dir)
+	for _, f := range files {
+		if !f.IsDir() && licenseFileName.MatchString(f.Name()) {
+			return filepath.Join(dir, f.Name())
+		}
+	}
+	return ""
+}
+
+// matchLicense returns the ids of the licenses of the catalogue whose
+// template is the most similar to the text, or none if no one is similar
+// enough. Licenses sharing a template (i.e. GPL-2.0-only and
+// GPL-2.0-or-later) can't be told apart by their text, so all are returned
+func matchLicense(text string) []string {
+	var best []string
+	bestScore := 0.0
+	textWords := wordPairs(text)
+	for _, license := range licenses {
+		b, err := ioutil.ReadFile(filepath.Join(GOBIPATH, "templates", "license", license.Template))
+		if err != nil {
+			continue
+		}
+		switch score := similarity(textWords, wordPairs(string(b))); {
+		case score > bestScore:
+			best, bestScore = []string{license.ID}, score
+		case score == bestScore && score > 0:
+			best = append(best, license.ID)
+		}
+	}
+	if bestScore < licenseSimilarity {
+		return nil
+	}
+	return best
+}
+
+// chooseLicense among the ones matching the license file of the dependency,
+// by the SPDX ids of its source files. Without them the ´-or-later´ one is
+// chosen, as the GNU licenses allow any version if the program sets none
+func (dep Dependency) chooseLicense(ids []string) string {
+	switch len(ids) {
+	case 0:
+		return ""
+	case 1:
+		return ids[0]
+	}
+	files, _ := filepath.Glob(filepath.Join(dep.Dir, "*.go"))
+	for _, file := range files {
+		b, _ := ioutil.ReadFile(file)
+		for _, m := range spdxIdentifier.FindAllStringSubmatch(string(b), -1) {
+			for _, id := range ids {
+				if strings.EqualFold(m[1], id) {
+					return id
+				}
+			}
+		}
+	}
+	for _, id := range ids {
+		if strings.HasSuffix(id, "-or-later") {
+			return id
+		}
+	}
+	return ids[0]
+}
+
+// wordPairs of a text, lower cased and without punctuation nor template actions
+func wordPairs(text string) map[string]bool {
+	text = templateAction.ReplaceAllString(text, " ")
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	pairs := make(map[string]bool)
+	for i := 0; i+1 < len(words); i++ {
+		pairs[words[i]+" "+words[i+1]] = true
+	}
+	return pairs
+}
+
+// similarity of two sets of word pairs (Sørensen–Dice coefficient)
+func similarity(a, b map[string]bool) float64 {
+	if len(a)+len(b) == 0 {
+		return 0
+	}
+	common := 0
+	for p := range a {
+		if b[p] {
+			common++
+		}
+	}
+	return 2 * float64(common) / float64(len(a)+len(b))
+}
+
+// checkCompatibility of the license of the dependency with any of the
+// licenses of the project, explaining the problem if there is one
+func (dep *Dependency) checkCompatibility(projLicenses []License) {
+	switch {
+	case dep.Dir == "":
+		dep.Problem = "source not found"
+		return
+	case dep.File == "":
+		dep.Problem = "no license file"
+		return
+	case dep.License == "":
+		dep.Problem = "unknown license"
+		return
+	case dep.License == "NONE":
+		dep.Problem = "all rights reserved"
+		return
+	}
+	for _, l := range projLicenses {
+		if compatibleLicenses(dep.License, l.ID) {
+			return
+		}
+	}
+	dep.Problem = "incompatible with the project license"
+}
+
+// compatibleLicenses returns true if code under the dependency license
+// can be used by a project under the project license. It is an approximation,
+// check the licenses if in doubt
+func compatibleLicenses(dep, proj string) bool {
+	if dep == proj {
+		return true
+	}
+	switch licenseKinds[dep] {
+	case networkCopyleft:
+		return licenseKinds[proj] == networkCopyleft
+	case strongCopyleft:
+		if licenseKinds[proj] < strongCopyleft {
+			return false
+		}
+		// GPL 2.0 only and GPL 3.0 can't be combined,
+		// GPL 2.0 or later can be used as any of them
+		switch dep {
+		case "GPL-2.0-only":
+			return strings.HasPrefix(proj, "GPL-2.0")
+		case "GPL-2.0-or-later":
+			return true
+		}
+		return proj != "GPL-2.0-only"
+	}
+	if gnuIncompatible[dep] && strings.Contains(proj, "GPL") {
+		return false
+	}
+	// Apache 2.0 and LGPL 3.0 are incompatible with GPL 2.0 only
+	if proj == "GPL-2.0-only" {
+		return dep != "Apache-2.0" && !strings.HasPrefix(dep, "LGPL-3.0")
+	}
+	return true
+}
+
+// thirdPartyNotices returns the content of the notices file
+// with the license of every dependency
+func thirdPartyNotices(deps []Dependency) string {
+	var b strings.Builder
+	b.WriteString("THIRD PARTY NOTICES\n\n")
+	b.WriteString("This project uses the following third party software:\n\n")
+	for _, dep := range deps {
+		license := dep.License
+		if license == "" {
+			license = "unknown"
+		}
+		fmt.Fprintf(&b, "- %s %s (%s)\n", dep.Path, dep.Version, license)
+	}
+	for _, dep := range deps {
+		if dep.File == "" {
+			continue
+		}
+		text, _ := ioutil.ReadFile(dep.File)
+		fmt.Fprintf(&b, "\n%s\n%s\n\n%s\n", strings.Repeat("=", 80), dep.Path, strings.TrimSpace(string(text)))
+	}
+	return b.String()
+}
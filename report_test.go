@@ -0,0 +1,129 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCompatibleLicenses(t *testing.T) {
+	tests := []struct {
+		dep, proj string
+		want      bool
+	}{
+		{"MIT", "MIT", true},
+		{"MIT", "GPL-3.0-only", true},
+		{"BSD-3-Clause", "Apache-2.0", true},
+		{"Apache-2.0", "GPL-3.0-or-later", true},
+		{"Apache-2.0", "GPL-2.0-only", false},
+		{"MPL-2.0", "GPL-3.0-only", true},
+		{"LGPL-2.1-only", "GPL-2.0-only", true},
+		{"LGPL-3.0-only", "GPL-2.0-only", false},
+		{"LGPL-3.0-or-later", "GPL-2.0-only", false},
+		{"LGPL-3.0-only", "GPL-2.0-or-later", true},
+		{"LGPL-2.1-only", "MIT", true},
+		{"EPL-1.0", "MIT", true},
+		{"EPL-1.0", "GPL-2.0-or-later", false},
+		{"EPL-1.0", "GPL-3.0-only", false},
+		{"EPL-1.0", "LGPL-3.0-only", false},
+		{"EPL-1.0", "AGPL-3.0-only", false},
+		{"GPL-2.0-only", "MIT", false},
+		{"GPL-2.0-only", "GPL-2.0-or-later", true},
+		{"GPL-2.0-only", "GPL-3.0-only", false},
+		{"GPL-2.0-or-later", "GPL-3.0-only", true},
+		{"GPL-2.0-or-later", "GPL-2.0-only", true},
+		{"GPL-2.0-or-later", "AGPL-3.0-only", true},
+		{"GPL-3.0-only", "GPL-2.0-only", false},
+		{"GPL-3.0-or-later", "AGPL-3.0-only", true},
+		{"AGPL-3.0-only", "GPL-3.0-only", false},
+		{"AGPL-3.0-only", "AGPL-3.0-or-later", true},
+	}
+	for _, test := range tests {
+		if got := compatibleLicenses(test.dep, test.proj); got != test.want {
+			t.Errorf("%s used by %s: got %v, want %v", test.dep, test.proj, got, test.want)
+		}
+	}
+}
+
+func TestMatchLicense(t *testing.T) {
+	defer func(path string) { GOBIPATH = path }(GOBIPATH)
+	GOBIPATH = "."
+
+	tests := []struct {
+		template string
+		want     []string
+	}{
+		{"MIT.tpl", []string{"MIT"}},
+		{"Apache-2.0.tpl", []string{"Apache-2.0"}},
+		{"GPL-2.0.tpl", []string{"GPL-2.0-only", "GPL-2.0-or-later"}},
+		{"GPL-3.0.tpl", []string{"GPL-3.0-only", "GPL-3.0-or-later"}},
+	}
+	for _, test := range tests {
+		b, err := ioutil.ReadFile(filepath.Join("templates", "license", test.template))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// License files have the holder and year instead of template actions
+		text := templateAction.ReplaceAllString(string(b), "2042 Jane Doe")
+		if got := matchLicense(text); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.template, got, test.want)
+		}
+	}
+	if got := matchLicense("All the code is mine, do not touch it."); got != nil {
+		t.Errorf("Unknown license: got %v, want none", got)
+	}
+}
+
+func TestChooseLicense(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gobi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	gpl2 := []string{"GPL-2.0-only", "GPL-2.0-or-later"}
+
+	dep := Dependency{Dir: dir}
+	if got := dep.chooseLicense(gpl2); got != "GPL-2.0-or-later" {
+		t.Errorf("No SPDX id: got %s, want GPL-2.0-or-later", got)
+	}
+	ioutil.WriteFile(filepath.Join(dir, "foo.go"),
+		[]byte("// SPDX-License-Identifier: GPL-2.0-only\n\npackage foo\n"), 0644)
+	if got := dep.chooseLicense(gpl2); got != "GPL-2.0-only" {
+		t.Errorf("SPDX id on source: got %s, want GPL-2.0-only", got)
+	}
+	if got := dep.chooseLicense([]string{"MIT"}); got != "MIT" {
+		t.Errorf("Single license: got %s, want MIT", got)
+	}
+	if got := dep.chooseLicense(nil); got != "" {
+		t.Errorf("No license: got %s, want none", got)
+	}
+}
+
+func TestParseGoMod(t *testing.T) {
+	gomod := `module github.com/test/foo
+
+go 1.22
+
+require github.com/single/dep v1.0.0
+
+require (
+	github.com/block/one v0.1.0 // indirect
+	github.com/block/two v2.0.0+incompatible
+
+	// A comment
+)
+
+replace github.com/block/one => ../one
+`
+	want := []Dependency{
+		{Path: "github.com/single/dep", Version: "v1.0.0"},
+		{Path: "github.com/block/one", Version: "v0.1.0"},
+		{Path: "github.com/block/two", Version: "v2.0.0+incompatible"},
+	}
+	if got := parseGoMod(strings.NewReader(gomod)); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
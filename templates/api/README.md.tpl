@@ -0,0 +1,48 @@
+# {{.FirstName}}
+
+[Documentation online](http://godoc.org/{{.GoGetName}})
+
+**{{.SecondName}}** is a JSON REST API written in Go generated automatically by `gobi`. Happy hacking!
+
+Routes are versioned under `/v1` and described on `openapi.yaml`. Errors always have the same shape:
+
+```
+{"error": {"code": "not_found", "message": "item not found"}}
+```
+
+The example `items` resource is kept on memory behind the `ItemStore` interface, so it can be replaced by a database without touching the handlers.
+
+## Install (with GOPATH set on your machine)
+----------
+
+* Step 1: Get the `{{.SecondName}}` package
+
+```
+go get {{.GoGetName}}
+```
+
+* Step 2 (Optional): Run tests
+
+```
+$ go test -v ./...
+```
+
+##Usage
+----------
+
+* Run it locally, on $PORT (8080 by default)
+
+```
+$ {{.SecondName}}
+```
+
+* Try it
+
+```
+$ curl -X POST -d '{"name":"gopher"}' localhost:8080/v1/items
+$ curl localhost:8080/v1/items
+```
+
+##License
+----------
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
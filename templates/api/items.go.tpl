@@ -0,0 +1,216 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Item is the example resource of the API. Replace it with your own
+type Item struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// itemInput is the body accepted when creating or updating an Item
+type itemInput struct {
+	Name string `json:"name"`
+}
+
+// validate the input, returning a message for the client if wrong
+func (in itemInput) validate() string {
+	if strings.TrimSpace(in.Name) == "" {
+		return "name must not be empty"
+	}
+	return ""
+}
+
+// errNotFound is returned by stores when an item does not exist
+var errNotFound = errors.New("not found")
+
+// ItemStore keeps the items. The API only depends on this interface,
+// so the in-memory store can be replaced by a database
+type ItemStore interface {
+	List() ([]Item, error)
+	Get(id int64) (Item, error)
+	Create(name string) (Item, error)
+	Update(id int64, name string) (Item, error)
+	Delete(id int64) error
+}
+
+// memoryStore is an ItemStore safe for concurrent use,
+// whose items are lost when the process stops
+type memoryStore struct {
+	mu     sync.Mutex
+	lastID int64
+	items  map[int64]Item
+}
+
+// newMemoryStore without items
+func newMemoryStore() *memoryStore {
+	return &memoryStore{items: make(map[int64]Item)}
+}
+
+func (s *memoryStore) List() ([]Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	items := make([]Item, 0, len(s.items))
+	for _, item := range s.items {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ID < items[j].ID })
+	return items, nil
+}
+
+func (s *memoryStore) Get(id int64) (Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.items[id]
+	if !ok {
+		return Item{}, errNotFound
+	}
+	return item, nil
+}
+
+func (s *memoryStore) Create(name string) (Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastID++
+	now := time.Now().UTC()
+	item := Item{ID: s.lastID, Name: name, CreatedAt: now, UpdatedAt: now}
+	s.items[item.ID] = item
+	return item, nil
+}
+
+func (s *memoryStore) Update(id int64, name string) (Item, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.items[id]
+	if !ok {
+		return Item{}, errNotFound
+	}
+	item.Name = name
+	item.UpdatedAt = time.Now().UTC()
+	s.items[id] = item
+	return item, nil
+}
+
+func (s *memoryStore) Delete(id int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.items[id]; !ok {
+		return errNotFound
+	}
+	delete(s.items, id)
+	return nil
+}
+
+// listItems answers with all the items
+func (a *api) listItems(w http.ResponseWriter, r *http.Request) {
+	items, err := a.items.List()
+	if err != nil {
+		a.storeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string][]Item{"items": items})
+}
+
+// getItem answers with the item of the path
+func (a *api) getItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := itemID(w, r)
+	if !ok {
+		return
+	}
+	item, err := a.items.Get(id)
+	if err != nil {
+		a.storeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+// createItem from the body of the request
+func (a *api) createItem(w http.ResponseWriter, r *http.Request) {
+	in, ok := itemBody(w, r)
+	if !ok {
+		return
+	}
+	item, err := a.items.Create(in.Name)
+	if err != nil {
+		a.storeError(w, err)
+		return
+	}
+	w.Header().Set("Location", "/v1/items/"+strconv.FormatInt(item.ID, 10))
+	writeJSON(w, http.StatusCreated, item)
+}
+
+// updateItem of the path with the body of the request
+func (a *api) updateItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := itemID(w, r)
+	if !ok {
+		return
+	}
+	in, ok := itemBody(w, r)
+	if !ok {
+		return
+	}
+	item, err := a.items.Update(id, in.Name)
+	if err != nil {
+		a.storeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, item)
+}
+
+// deleteItem of the path
+func (a *api) deleteItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := itemID(w, r)
+	if !ok {
+		return
+	}
+	if err := a.items.Delete(id); err != nil {
+		a.storeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// itemID of the path, answering with an error if it is wrong
+func itemID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id < 1 {
+		writeError(w, http.StatusBadRequest, "invalid_id", "id must be a positive integer")
+		return 0, false
+	}
+	return id, true
+}
+
+// itemBody of the request, answering with an error if it is wrong
+func itemBody(w http.ResponseWriter, r *http.Request) (itemInput, bool) {
+	var in itemInput
+	if err := readJSON(w, r, &in); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_body", err.Error())
+		return in, false
+	}
+	if msg := in.validate(); msg != "" {
+		writeError(w, http.StatusUnprocessableEntity, "invalid_item", msg)
+		return in, false
+	}
+	return in, true
+}
+
+// storeError answers with the error returned by the store
+func (a *api) storeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errNotFound) {
+		writeError(w, http.StatusNotFound, "not_found", "item not found")
+		return
+	}
+	a.logger.Error("store failed", "error", err)
+	writeError(w, http.StatusInternalServerError, "internal", "internal error")
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Maximum size of a request body
+const maxBodySize = 1 << 20
+
+// errorEnvelope is the body of every error response:
+//
+//	{"error": {"code": "not_found", "message": "item not found"}}
+type errorEnvelope struct {
+	Error apiError `json:"error"`
+}
+
+// apiError has a stable code for programs and a message for people
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeJSON encodes v as the body of the response
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeError answers with the error envelope
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, errorEnvelope{apiError{code, message}})
+}
+
+// readJSON decodes the body of the request into v. Unknown fields,
+// trailing data and bodies bigger than maxBodySize are rejected
+func readJSON(w http.ResponseWriter, r *http.Request, v any) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		var maxErr *http.MaxBytesError
+		switch {
+		case errors.Is(err, io.EOF):
+			return errors.New("body must not be empty")
+		case errors.As(err, &maxErr):
+			return fmt.Errorf("body must not be larger than %d bytes", maxErr.Limit)
+		default:
+			return fmt.Errorf("malformed body: %v", err)
+		}
+	}
+	if dec.More() {
+		return errors.New("body must contain a single JSON value")
+	}
+	return nil
+}
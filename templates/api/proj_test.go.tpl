@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newTestAPI with two items already created
+func newTestAPI(t *testing.T) http.Handler {
+	t.Helper()
+	store := newMemoryStore()
+	store.Create("first")
+	store.Create("second")
+	return newAPI(slog.New(slog.NewTextHandler(io.Discard, nil)), store).routes()
+}
+
+func TestRoutes(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		status int
+		want   string
+	}{
+		{"health", "GET", "/healthz", "", http.StatusOK, `"status":"ok"`},
+		{"list", "GET", "/v1/items", "", http.StatusOK, `"name":"second"`},
+		{"get", "GET", "/v1/items/1", "", http.StatusOK, `"name":"first"`},
+		{"get missing", "GET", "/v1/items/9", "", http.StatusNotFound, `"code":"not_found"`},
+		{"get wrong id", "GET", "/v1/items/one", "", http.StatusBadRequest, `"code":"invalid_id"`},
+		{"create", "POST", "/v1/items", `{"name":"third"}`, http.StatusCreated, `"id":3`},
+		{"create empty body", "POST", "/v1/items", "", http.StatusBadRequest, `"code":"invalid_body"`},
+		{"create unknown field", "POST", "/v1/items", `{"nam":"x"}`, http.StatusBadRequest, `"code":"invalid_body"`},
+		{"create empty name", "POST", "/v1/items", `{"name":" "}`, http.StatusUnprocessableEntity, `"code":"invalid_item"`},
+		{"update", "PUT", "/v1/items/2", `{"name":"changed"}`, http.StatusOK, `"name":"changed"`},
+		{"update missing", "PUT", "/v1/items/9", `{"name":"x"}`, http.StatusNotFound, `"code":"not_found"`},
+		{"delete", "DELETE", "/v1/items/1", "", http.StatusNoContent, ""},
+		{"delete missing", "DELETE", "/v1/items/9", "", http.StatusNotFound, `"code":"not_found"`},
+		{"unknown route", "GET", "/v2/items", "", http.StatusNotFound, `"code":"not_found"`},
+		{"wrong method", "PATCH", "/v1/items/1", "", http.StatusMethodNotAllowed, `"code":"method_not_allowed"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			newTestAPI(t).ServeHTTP(rec, req)
+			if rec.Code != tt.status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if !strings.Contains(rec.Body.String(), tt.want) {
+				t.Errorf("body = %s, want it to contain %s", rec.Body.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorEnvelope(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newTestAPI(t).ServeHTTP(rec, httptest.NewRequest("GET", "/v1/items/9", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("content type = %q, want application/json", ct)
+	}
+	var env errorEnvelope
+	if err := json.NewDecoder(rec.Body).Decode(&env); err != nil {
+		t.Fatal(err)
+	}
+	if env.Error.Code != "not_found" || env.Error.Message == "" {
+		t.Errorf("error = %+v, want a not_found code with a message", env.Error)
+	}
+}
+
+func TestCreateSetsLocation(t *testing.T) {
+	req := httptest.NewRequest("POST", "/v1/items", strings.NewReader(`{"name":"third"}`))
+	rec := httptest.NewRecorder()
+	newTestAPI(t).ServeHTTP(rec, req)
+	if loc := rec.Header().Get("Location"); loc != "/v1/items/3" {
+		t.Errorf("location = %q, want /v1/items/3", loc)
+	}
+}
@@ -0,0 +1,93 @@
+// Routes match on method and path, even when built without go.mod
+//go:debug httpmuxgo121=0
+
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// api of {{.SecondName}} with its dependencies
+type api struct {
+	logger *slog.Logger
+	items  ItemStore
+}
+
+// newAPI using the given store
+func newAPI(logger *slog.Logger, items ItemStore) *api {
+	return &api{logger: logger, items: items}
+}
+
+// routes of the API. Every version has its own prefix,
+// so a /v2 can be added without breaking /v1 clients
+func (a *api) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", a.handleHealth)
+
+	mux.HandleFunc("GET /v1/items", a.listItems)
+	mux.HandleFunc("POST /v1/items", a.createItem)
+	mux.HandleFunc("GET /v1/items/{id}", a.getItem)
+	mux.HandleFunc("PUT /v1/items/{id}", a.updateItem)
+	mux.HandleFunc("DELETE /v1/items/{id}", a.deleteItem)
+	mux.Handle("/v1/items", methodNotAllowed("GET, POST"))
+	mux.Handle("/v1/items/{id}", methodNotAllowed("GET, PUT, DELETE"))
+
+	// Anything else is answered with the error envelope
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "not_found", "route not found")
+	})
+	return a.withLogging(a.withRecovery(mux))
+}
+
+// methodNotAllowed answers routes requested with a method they don't support
+func methodNotAllowed(allow string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", r.Method+" is not allowed")
+	})
+}
+
+// handleHealth tells the process is alive
+func (a *api) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// withLogging logs every request once served
+func (a *api) withLogging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		a.logger.Info("request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"duration", time.Since(start))
+	})
+}
+
+// withRecovery answers with an internal error when a handler panics
+func (a *api) withRecovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+				a.logger.Error("panic", "error", err)
+				writeError(w, http.StatusInternalServerError, "internal", "internal error")
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// statusRecorder keeps the status code written to a response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,22 @@
+{{- range $i, $v := .GoVersions}}{{if $i}}
+---
+{{end}}kind: pipeline
+type: docker
+name: go-{{$v}}
+
+steps:
+  - name: test
+    image: golang:{{$v}}
+    commands:
+{{- if eq $.Typ "grpc"}}
+      - cd {{$.ModuleDir}}
+      # The Go code of the services is generated from proto/
+      - apt-get update && apt-get install -y protobuf-compiler
+      - make tools generate
+{{- end}}
+      # Projects created on a GOPATH have no go.mod yet
+      - "[ -f go.mod ] || (go mod init {{$.RootGoGetName}} && go mod tidy)"
+      - go vet ./...
+      - go test -race ./...
+      - go build -o /dev/null ./...
+{{end -}}
@@ -0,0 +1,38 @@
+name: CI
+
+on:
+  push:
+  pull_request:
+
+jobs:
+  test:
+    runs-on: ubuntu-latest
+{{- if eq .Typ "grpc"}}
+    defaults:
+      run:
+        working-directory: {{.ModuleDir}}
+{{- end}}
+    strategy:
+      matrix:
+        go: [{{range $i, $v := .GoVersions}}{{if $i}}, {{end}}"{{$v}}"{{end}}]
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+        with:
+          go-version: {{"${{ matrix.go }}"}}
+{{- if eq .Typ "grpc"}}
+      # The Go code of the services is generated from proto/
+      - name: Generate
+        run: |
+          sudo apt-get update && sudo apt-get install -y protobuf-compiler
+          make tools generate
+{{- end}}
+      # Projects created on a GOPATH have no go.mod yet
+      - name: Init module
+        run: "[ -f go.mod ] || (go mod init {{.RootGoGetName}} && go mod tidy)"
+      - name: Vet
+        run: go vet ./...
+      - name: Test
+        run: go test -race ./...
+      - name: Build
+        run: go build -o /dev/null ./...
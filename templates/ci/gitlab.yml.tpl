@@ -0,0 +1,22 @@
+stages:
+  - test
+
+test:
+  stage: test
+  image: golang:$GO_VERSION
+  parallel:
+    matrix:
+      - GO_VERSION: [{{range $i, $v := .GoVersions}}{{if $i}}, {{end}}"{{$v}}"{{end}}]
+  before_script:
+{{- if eq .Typ "grpc"}}
+    - cd {{.ModuleDir}}
+    # The Go code of the services is generated from proto/
+    - apt-get update && apt-get install -y protobuf-compiler
+    - make tools generate
+{{- end}}
+    # Projects created on a GOPATH have no go.mod yet
+    - "[ -f go.mod ] || (go mod init {{.RootGoGetName}} && go mod tidy)"
+  script:
+    - go vet ./...
+    - go test -race ./...
+    - go build -o /dev/null ./...
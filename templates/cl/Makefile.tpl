@@ -0,0 +1,13 @@
+VERSION := $(shell cat {{.RootPath}}/VERSION)
+LDFLAGS := -X main.version=$(VERSION)
+
+.PHONY: build install test
+
+build:
+	go build -ldflags "$(LDFLAGS)" -o {{.SecondName}} .
+
+install:
+	go install -ldflags "$(LDFLAGS)" .
+
+test:
+	go test ./...
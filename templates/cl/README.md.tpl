@@ -19,12 +19,32 @@ go get {{.GoGetName}}
 $ go test -v ./...
 ```
 
+* Step 3 (Optional): Build it with its version, read from the VERSION file
+
+```
+$ make build
+```
+
 ##Usage
 -------
 ```
-$ {{.SecondName}}
+$ {{.SecondName}} [-config FILE] [-version] COMMAND [ARGS...]
+$ {{.SecondName}} hello gopher
+Hello, gopher!
+$ {{.SecondName}} version
 ```
 
+The config file is a JSON file like `{"greeting": "Hi"}`. Add your own commands to the `commands` list.
+
+Exit codes are `0` on success, `1` on errors, `2` on wrong usage and `130` when interrupted.
+{{if .Opts.Docker}}
+* Run it on a container, built from the directory of `{{.SecondName}}`
+
+```
+$ docker build --build-arg VERSION=$(cat {{.RootPath}}/VERSION) -t {{.SecondName}} .
+$ docker run --rm {{.SecondName}} hello gopher
+```
+{{end}}
 ##License
 ---------
-{{.FirstName}} is {{.License}} licensed.
\ No newline at end of file
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
@@ -1,18 +1,178 @@
 /*
 {{.SecondName}} is a command line tool automatically generated by ´gobi´. Happy hacking!
+
+Usage:
+
+	{{.SecondName}} [-config FILE] [-version] COMMAND [ARGS...]
+
+Commands:
+
+	hello [NAME]  Greets NAME, or the world if no name is given.
+	version       Shows the version.
 */
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// version of {{.SecondName}}, set when building with
+// -ldflags "-X main.version=$(cat VERSION)" (see Makefile)
+var version = "dev"
+
+// Exit codes of {{.SecondName}}
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitInterrupted = 130
 )
 
+// errUsage is returned by commands called with wrong arguments
+var errUsage = errors.New("wrong usage")
+
+// Config of {{.SecondName}}, read from the JSON file given with -config
+type Config struct {
+	Greeting string `json:"greeting"`
+}
+
+// defaultConfig is used when no config file is given
+func defaultConfig() Config {
+	return Config{Greeting: "Hello"}
+}
+
+// loadConfig from a JSON file over the default config
+func loadConfig(path string) (Config, error) {
+	config := defaultConfig()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	err = json.Unmarshal(b, &config)
+	return config, err
+}
+
+// env is the environment shared by all commands
+type env struct {
+	config Config
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// command of {{.SecondName}}
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, e env, args []string) error
+}
+
+// commands of {{.SecondName}}, add yours here
+var commands = []command{
+	{"hello", "hello [NAME]", runHello},
+	{"version", "version", runVersion},
+}
+
 func main() {
-	var f string
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run {{.SecondName}} with the given arguments, returning the exit code
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("{{.SecondName}}", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	configFile := fs.String("config", "", "JSON config `file`")
+	showVersion := fs.Bool("version", false, "show the version and exit")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: {{.SecondName}} [-config FILE] [-version] COMMAND [ARGS...]")
+		fmt.Fprintln(stderr, "\nCommands:")
+		for _, cmd := range commands {
+			fmt.Fprintln(stderr, "  "+cmd.usage)
+		}
+		fmt.Fprintln(stderr, "\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *showVersion {
+		fmt.Fprintln(stdout, version)
+		return exitOK
+	}
+
+	e := env{config: defaultConfig(), stdout: stdout, stderr: stderr}
+	if *configFile != "" {
+		config, err := loadConfig(*configFile)
+		if err != nil {
+			fmt.Fprintln(stderr, "error: reading config:", err)
+			return exitError
+		}
+		e.config = config
+	}
 
-	flag.StringVar(&f, "f", "gobi is awesome", "Just prints.")
-	flag.Parse()
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return exitUsage
+	}
+	name := fs.Arg(0)
+	for _, cmd := range commands {
+		if cmd.name != name {
+			continue
+		}
+		err := cmd.run(ctx, e, fs.Args()[1:])
+		switch {
+		case err == nil:
+			return exitOK
+		case errors.Is(err, errUsage):
+			fmt.Fprintln(stderr, "Usage: {{.SecondName}}", cmd.usage)
+			return exitUsage
+		case ctx.Err() != nil:
+			fmt.Fprintln(stderr, "interrupted")
+			return exitInterrupted
+		default:
+			fmt.Fprintln(stderr, "error:", err)
+			return exitError
+		}
+	}
+	fmt.Fprintf(stderr, "unknown command %q\n", name)
+	fs.Usage()
+	return exitUsage
+}
+
+// runHello greets the given name
+func runHello(ctx context.Context, e env, args []string) error {
+	if len(args) > 1 {
+		return errUsage
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	name := "world"
+	if len(args) == 1 {
+		name = args[0]
+	}
+	_, err := fmt.Fprintf(e.stdout, "%s, %s!\n", e.config.Greeting, name)
+	return err
+}
 
-	fmt.Println(f)
+// runVersion shows the version
+func runVersion(ctx context.Context, e env, args []string) error {
+	if len(args) > 0 {
+		return errUsage
+	}
+	_, err := fmt.Fprintln(e.stdout, version)
+	return err
 }
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	config := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(config, []byte(`{"greeting": "Hi"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stdout string
+	}{
+		{"no command", nil, exitUsage, ""},
+		{"help", []string{"-h"}, exitOK, ""},
+		{"version flag", []string{"-version"}, exitOK, version + "\n"},
+		{"version", []string{"version"}, exitOK, version + "\n"},
+		{"hello", []string{"hello"}, exitOK, "Hello, world!\n"},
+		{"hello name", []string{"hello", "gopher"}, exitOK, "Hello, gopher!\n"},
+		{"hello config", []string{"-config", config, "hello"}, exitOK, "Hi, world!\n"},
+		{"hello too many", []string{"hello", "a", "b"}, exitUsage, ""},
+		{"missing config", []string{"-config", "missing.json", "hello"}, exitError, ""},
+		{"unknown command", []string{"foo"}, exitUsage, ""},
+		{"unknown flag", []string{"-foo"}, exitUsage, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if code := run(context.Background(), tt.args, &stdout, &stderr); code != tt.code {
+				t.Errorf("exit code = %d, want %d (stderr: %s)", code, tt.code, stderr.String())
+			}
+			if got := stdout.String(); got != tt.stdout {
+				t.Errorf("stdout = %q, want %q", got, tt.stdout)
+			}
+		})
+	}
+}
+
+func TestRunInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var stdout, stderr bytes.Buffer
+	if code := run(ctx, []string{"hello"}, &stdout, &stderr); code != exitInterrupted {
+		t.Errorf("exit code = %d, want %d", code, exitInterrupted)
+	}
+}
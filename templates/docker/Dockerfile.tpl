@@ -0,0 +1,21 @@
+# syntax=docker/dockerfile:1
+
+# Build a static binary of {{.SecondName}}
+FROM golang:{{.GoVersion}} AS build
+{{- if eq .Typ "cl"}}
+ARG VERSION=dev
+{{- end}}
+WORKDIR /src
+COPY . .
+# Projects created on a GOPATH have no go.mod yet
+RUN [ -f go.mod ] || go mod init {{.GoGetName}}
+RUN CGO_ENABLED=0 go build -trimpath -ldflags "-s -w{{if eq .Typ "cl"}} -X main.version=${VERSION}{{end}}" -o /out/{{.SecondName}} .
+
+# Run it as a non-root user on a minimal image
+FROM gcr.io/distroless/static-debian12:nonroot
+COPY --from=build /out/{{.SecondName}} /{{.SecondName}}
+{{- if eq .Typ "web"}}
+ENV PORT={{.Port}}
+EXPOSE {{.Port}}
+{{- end}}
+ENTRYPOINT ["/{{.SecondName}}"]
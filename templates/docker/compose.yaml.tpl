@@ -0,0 +1,9 @@
+services:
+  {{.ServiceName}}:
+    build: .
+    image: {{.ServiceName}}
+    ports:
+      - "{{.Port}}:{{.Port}}"
+    environment:
+      PORT: "{{.Port}}"
+    restart: unless-stopped
@@ -0,0 +1,22 @@
+# Version control
+.git
+.hg
+.fossil-settings
+
+# Container build files
+Dockerfile
+.dockerignore
+compose.yaml
+
+# Deployment files
+k8s/
+fly.toml
+Procfile
+*.service
+
+# Build and test output
+bin/
+{{.SecondName}}
+*.test
+*.out
+*.orig
@@ -0,0 +1,17 @@
+*.o
+*.a
+*.so
+_obj
+_test
+*/bin/*
+bin/*
+*.[568vq]
+[568vq].out
+*.cgo1.go
+*.cgo2.c
+_cgo_defun.c
+_cgo_gotypes.go
+_cgo_export.*
+_testmain.go
+*.exe
+.*.fossil
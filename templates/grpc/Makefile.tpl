@@ -0,0 +1,25 @@
+PROTO_DIR := proto
+GEN_DIR := gen
+PROTOS := $(shell find $(PROTO_DIR) -name "*.proto")
+
+.PHONY: generate tools build test
+
+# Go code of the services on proto/, needs protoc on your PATH
+generate:
+	mkdir -p $(GEN_DIR)
+	protoc -I $(PROTO_DIR) \
+		--go_out=$(GEN_DIR) --go_opt=paths=source_relative \
+		--go-grpc_out=$(GEN_DIR) --go-grpc_opt=paths=source_relative \
+		$(PROTOS)
+	go mod tidy
+
+# protoc plugins used by generate
+tools:
+	go install google.golang.org/protobuf/cmd/protoc-gen-go@latest
+	go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest
+
+build:
+	go build -o {{.SecondName}} .
+
+test:
+	go test ./...
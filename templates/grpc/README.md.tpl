@@ -0,0 +1,48 @@
+# {{.FirstName}}
+
+[Documentation online](http://godoc.org/{{.GoGetName}})
+
+**{{.SecondName}}** is a gRPC service written in Go generated automatically by `gobi`. Happy hacking!
+
+The service is defined on `proto/greeter/v1/greeter.proto` and its Go code is generated on `gen/`. `client/` has an example of how to call it.
+
+## Install
+----------
+
+* Step 1: Install [protoc](https://grpc.io/docs/protoc-installation/) and its Go plugins
+
+```
+$ make tools
+```
+
+* Step 2: Generate the Go code of the service and download the dependencies. Run it again every time you change `proto/`
+
+```
+$ make generate
+```
+
+* Step 3 (Optional): Run tests, which call the service through an in-memory connection
+
+```
+$ go test -v ./...
+```
+
+##Usage
+----------
+
+* Run the server, with reflection for tools like grpcurl
+
+```
+$ go run . -addr :50051 -reflection
+```
+
+* Call it
+
+```
+$ go run ./client -name gopher
+Hello, gopher!
+```
+
+##License
+----------
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
@@ -0,0 +1,41 @@
+/*
+client is an example of how to call the {{.SecondName}} service.
+
+	$ go run ./client -addr localhost:50051 -name gopher
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	greeterv1 "{{.GoGetName}}/gen/greeter/v1"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "address of the server")
+	name := flag.String("name", "gopher", "name to greet")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := greeterv1.NewGreeterClient(conn).SayHello(ctx, &greeterv1.SayHelloRequest{Name: *name})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(resp.GetMessage())
+}
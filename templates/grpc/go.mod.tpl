@@ -0,0 +1,3 @@
+module {{.GoGetName}}
+
+go 1.22
@@ -0,0 +1,22 @@
+// Sample service of {{.FirstName}}. Change it and run ´make generate´
+// to update the Go code on gen/.
+syntax = "proto3";
+
+package greeter.v1;
+
+option go_package = "{{.GoGetName}}/gen/greeter/v1;greeterv1";
+
+// Greeter greets people
+service Greeter {
+  // SayHello to someone
+  rpc SayHello(SayHelloRequest) returns (SayHelloResponse);
+}
+
+message SayHelloRequest {
+  // name of the person to greet, can't be empty
+  string name = 1;
+}
+
+message SayHelloResponse {
+  string message = 1;
+}
@@ -0,0 +1,79 @@
+/*
+{{.SecondName}} is a gRPC service automatically generated by ´gobi´. Happy hacking!
+
+It listens on -addr (:50051 by default), logs as JSON and stops
+gracefully on SIGINT or SIGTERM. Server reflection, used by tools
+like grpcurl, is enabled with -reflection.
+
+The service is defined on proto/, run ´make generate´ after changing it.
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Time given to running calls when shutting down
+const shutdownTimeout = 15 * time.Second
+
+func main() {
+	addr := flag.String("addr", ":50051", "address to listen on")
+	reflection := flag.Bool("reflection", false, "enable server reflection")
+	flag.Parse()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	if err := run(*addr, *reflection, logger); err != nil {
+		logger.Error("server failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run the server until it fails or receives SIGINT or SIGTERM
+func run(addr string, reflection bool, logger *slog.Logger) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	srv := newServer(reflection)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	errc := make(chan error, 1)
+	go func() {
+		logger.Info("listening", "addr", lis.Addr().String(), "reflection", reflection)
+		errc <- srv.Serve(lis)
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	logger.Info("shutting down")
+	gracefulStop(srv, shutdownTimeout)
+	return nil
+}
+
+// gracefulStop waits for running calls to finish, stopping
+// the server anyway if they take longer than the timeout
+func gracefulStop(srv *grpc.Server, timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		srv.Stop()
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	greeterv1 "{{.GoGetName}}/gen/greeter/v1"
+)
+
+// greeterServer implements the Greeter service of proto/greeter/v1
+type greeterServer struct {
+	greeterv1.UnimplementedGreeterServer
+}
+
+// SayHello to the name of the request
+func (greeterServer) SayHello(ctx context.Context, req *greeterv1.SayHelloRequest) (*greeterv1.SayHelloResponse, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name must not be empty")
+	}
+	return &greeterv1.SayHelloResponse{Message: "Hello, " + req.GetName() + "!"}, nil
+}
+
+// newServer with all the services registered,
+// and the reflection service if enabled
+func newServer(withReflection bool) *grpc.Server {
+	srv := grpc.NewServer()
+	greeterv1.RegisterGreeterServer(srv, greeterServer{})
+	if withReflection {
+		reflection.Register(srv)
+	}
+	return srv
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	greeterv1 "{{.GoGetName}}/gen/greeter/v1"
+)
+
+// newTestClient connected to a server running in memory
+func newTestClient(t *testing.T) greeterv1.GreeterClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := newServer(false)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return greeterv1.NewGreeterClient(conn)
+}
+
+func TestSayHello(t *testing.T) {
+	client := newTestClient(t)
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr codes.Code
+	}{
+		{"greets", "gopher", "Hello, gopher!", codes.OK},
+		{"empty name", "", "", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.SayHello(context.Background(), &greeterv1.SayHelloRequest{Name: tt.in})
+			if code := status.Code(err); code != tt.wantErr {
+				t.Fatalf("code = %v, want %v", code, tt.wantErr)
+			}
+			if got := resp.GetMessage(); got != tt.want {
+				t.Errorf("message = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReflection(t *testing.T) {
+	const reflectionService = "grpc.reflection.v1.ServerReflection"
+	if _, ok := newServer(true).GetServiceInfo()[reflectionService]; !ok {
+		t.Error("reflection should be registered when enabled")
+	}
+	if _, ok := newServer(false).GetServiceInfo()[reflectionService]; ok {
+		t.Error("reflection should not be registered when disabled")
+	}
+}
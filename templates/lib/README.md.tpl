@@ -0,0 +1,64 @@
+# {{.FirstName}}
+
+[Documentation online](http://godoc.org/{{.GoGetName}})
+
+**{{.SecondName}}** is a package written in Go with a command line tool, generated automatically by `gobi`. Happy hacking!
+
+The package is at the root and the command on `cmd/{{.SecondName}}`. Keep the command thin, so everything it does can be done by importing the package too.
+
+## Install (with GOPATH set on your machine)
+----------
+
+* Get the package, to use it from your code
+
+```
+go get {{.GoGetName}}
+```
+
+* Get the command, to use it as an executable
+
+```
+go get {{.GoGetName}}/cmd/{{.SecondName}}
+```
+
+* (Optional) Run the tests of both
+
+```
+$ go test -v ./...
+```
+
+##Usage as a package
+----------
+```
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"{{.GoGetName}}"
+)
+
+func main() {
+	ex, err := {{.SecondName}}.New(1, "gobi")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(ex.Id(), ex.Name())
+	// Output: 1 gobi
+}
+```
+
+More examples are on `example_test.go`, and shown on the documentation.
+
+##Usage as a command
+----------
+```
+$ {{.SecondName}} -id 2 gobi
+2 gobi
+```
+
+##License
+----------
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
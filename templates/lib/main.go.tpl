@@ -0,0 +1,45 @@
+/*
+{{.SecondName}} is the command of the {{.SecondName}} package, automatically generated by ´gobi´. Happy hacking!
+
+Usage:
+
+	{{.SecondName}} [-id ID] NAME
+
+Keep it thin: the logic belongs to the package, so other programs can use it too.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"{{.GoGetName}}"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run the command with its arguments, returning the exit code
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("{{.SecondName}}", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	id := fs.Int("id", 1, "id of the example")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintln(stderr, "usage: {{.SecondName}} [-id ID] NAME")
+		return 2
+	}
+
+	ex, err := {{.SecondName}}.New(*id, fs.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, "{{.SecondName}}:", err)
+		return 1
+	}
+	fmt.Fprintln(stdout, ex.Id(), ex.Name())
+	return 0
+}
@@ -1,4 +1,4 @@
-Copyright (c) 2013, {{.UserName}}
+Copyright (c) {{.Year}}, {{.UserName}}
 All rights reserved.
 
 Redistribution and use in source and binary forms, with or without modification,
@@ -1,4 +1,4 @@
-Copyright (c) 2013, {{.UserName}}
+Copyright (c) {{.Year}}, {{.UserName}}
 All rights reserved.
 
 Redistribution and use in source and binary forms, with or without modification,
@@ -11,7 +11,7 @@ are permitted provided that the following conditions are met:
   list of conditions and the following disclaimer in the documentation and/or
   other materials provided with the distribution.
 
-  Neither the name of the {organization} nor the names of its
+  Neither the name of {{.UserName}} nor the names of its
   contributors may be used to endorse or promote products derived from
   this software without specific prior written permission.
 
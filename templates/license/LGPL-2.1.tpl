@@ -470,8 +470,8 @@ safest to attach them to the start of each source file to most effectively
 convey the exclusion of warranty; and each file should have at least the
 "copyright" line and a pointer to where the full notice is found.
 
-    {{.Name}}
-    Copyright (C) 2013  {{.UserName}}
+    {{.FirstName}}: {{.Description}}
+    Copyright (C) {{.Year}}  {{.UserName}}
 
     This library is free software; you can redistribute it and/or
     modify it under the terms of the GNU Lesser General Public
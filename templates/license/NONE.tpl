@@ -0,0 +1 @@
+Copyright {{.Year}} {{.UserName}}
\ No newline at end of file
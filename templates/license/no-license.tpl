@@ -1 +0,0 @@
-Copyright 2013 {{.UserName}}
\ No newline at end of file
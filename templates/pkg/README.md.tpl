@@ -25,26 +25,40 @@ $ go test -v ./...
 package main
 
 import (
-  "fmt"
-  "os"
-  "{{.GoGetName}}"
+	"fmt"
+	"os"
+
+	"{{.GoGetName}}"
 )
 
 func main() {
-  {{.SecondName}}Example, err := {{.SecondName}}.New(1, "gobi")
-  if err != nil {
+	ex, err := {{.SecondName}}.New(1, "gobi")
+	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		os.Exit(1)
 	}
 
-  {{.SecondName}}Example.SetId({{.SecondName}}Example.Id() + 1)
-  {{.SecondName}}Example.SetName({{.SecondName}}Example.Name() + " is great")
-
-  fmt.Println({{.SecondName}}Example.Id(), {{.SecondName}}Example.Name())
-  // Output: 2 gobi is great
+	ex.SetId(ex.Id() + 1)
+	ex.SetName(ex.Name() + " is great")
+	fmt.Println(ex.Id(), ex.Name())
+	// Output: 2 gobi is great
 }
 ```
 
+More examples are on `example_test.go`, and shown on the documentation.
+
+* Run the benchmarks
+
+```
+$ go test -bench=.
+```
+
+* Fuzz `New` with random input
+
+```
+$ go test -fuzz=FuzzNew
+```
+
 ##License
 ----------
-{{.FirstName}} is {{.License}} licensed.
\ No newline at end of file
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
@@ -0,0 +1,14 @@
+/*
+Package {{.SecondName}} is a package automatically generated by ´gobi´. Happy hacking!
+
+Create a My{{.SecondName}}Example with New and change it with its setters:
+
+	ex, err := {{.SecondName}}.New(1, "gobi")
+	if err != nil {
+		// handle the error
+	}
+	ex.SetName("gopher")
+
+See the examples for more.
+*/
+package {{.SecondName}}
@@ -1,21 +0,0 @@
-package main
-
-import (
-  "fmt"
-  "os"
-  "{{.GoGetName}}"
-)
-
-func main() {
-  {{.SecondName}}Example, err := {{.SecondName}}.New(1, "gobi")
-  if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		os.Exit(1)
-	}
-
-  {{.SecondName}}Example.SetId({{.SecondName}}Example.Id() + 1)
-  {{.SecondName}}Example.SetName({{.SecondName}}Example.Name() + " is great")
-
-  fmt.Println({{.SecondName}}Example.Id(), {{.SecondName}}Example.Name())
-  // Output: 2 gobi is great
-}
\ No newline at end of file
@@ -0,0 +1,33 @@
+package {{.SecondName}}_test
+
+import (
+	"fmt"
+
+	"{{.GoGetName}}"
+)
+
+func ExampleNew() {
+	ex, err := {{.SecondName}}.New(1, "gobi")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	ex.SetId(ex.Id() + 1)
+	ex.SetName(ex.Name() + " is great")
+	fmt.Println(ex.Id(), ex.Name())
+	// Output: 2 gobi is great
+}
+
+func ExampleNew_emptyName() {
+	_, err := {{.SecondName}}.New(1, "")
+	fmt.Println(err)
+	// Output: name is empty
+}
+
+func ExampleMy{{.SecondName}}Example_SetName() {
+	ex, _ := {{.SecondName}}.New(1, "gobi")
+	ex.SetName("gopher")
+	fmt.Println(ex.Name())
+	// Output: gopher
+}
@@ -1,22 +1,22 @@
-/*
-{{.SecondName}} is a package automatically generated by ´gobi´. Happy hacking!
-*/
 package {{.SecondName}}
 
 import (
 	"errors"
 )
 
+// ErrEmptyName is returned by New when the name is empty
+var ErrEmptyName = errors.New("name is empty")
+
 // My{{.SecondName}}Example is a example type automatically generated by ´gobi´.
 type My{{.SecondName}}Example struct {
-	id 	 int
+	id   int
 	name string
 }
 
 // New creates a new *My{{.SecondName}}Example object
 func New(id int, name string) (*My{{.SecondName}}Example, error) {
 	if name == "" {
-		return nil, errors.New("Name is empty.")
+		return nil, ErrEmptyName
 	}
 
 	return &My{{.SecondName}}Example{id, name}, nil
@@ -41,7 +41,3 @@ func (ex *My{{.SecondName}}Example) SetId(id int) {
 func (ex *My{{.SecondName}}Example) SetName(name string) {
 	ex.name = name
 }
-
-
-
-
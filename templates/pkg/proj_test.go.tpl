@@ -1,60 +1,93 @@
 package {{.SecondName}}
 
 import (
-	"fmt"
+	"errors"
 	"testing"
 )
 
-var (
-	msgFail = "%v method fails. Expects %v, returns %v"
-	ex 			= My{{.SecondName}}Example{id: 1, name: "foo"}
-)
-
 func TestNew(t *testing.T) {
-	if exNew, err := New(1, "foo"); err != nil {
-		t.Errorf("%v", err)
-	} else if *exNew != ex {
-		t.Errorf(msgFail, "New", ex, *exNew)
+	tests := []struct {
+		name    string
+		id      int
+		exName  string
+		want    My{{.SecondName}}Example
+		wantErr error
+	}{
+		{"valid", 1, "foo", My{{.SecondName}}Example{id: 1, name: "foo"}, nil},
+		{"negative id", -1, "foo", My{{.SecondName}}Example{id: -1, name: "foo"}, nil},
+		{"empty name", 1, "", My{{.SecondName}}Example{}, ErrEmptyName},
 	}
-}
-
-func TestId(t *testing.T) {
-	if id := ex.Id(); id != 1 {
-		t.Errorf(msgFail, "Id", 1, id)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ex, err := New(tt.id, tt.exName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("New(%d, %q) error = %v, want %v", tt.id, tt.exName, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if *ex != tt.want {
+				t.Errorf("New(%d, %q) = %+v, want %+v", tt.id, tt.exName, *ex, tt.want)
+			}
+		})
 	}
 }
 
-func TestName(t *testing.T) {
-	if name := ex.Name(); name != "foo" {
-		t.Errorf(msgFail, "Name", "foo", name)
+func TestSetters(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      func(ex *My{{.SecondName}}Example)
+		wantId   int
+		wantName string
+	}{
+		{"nothing", func(*My{{.SecondName}}Example) {}, 1, "foo"},
+		{"id", func(ex *My{{.SecondName}}Example) { ex.SetId(2) }, 2, "foo"},
+		{"name", func(ex *My{{.SecondName}}Example) { ex.SetName("bar") }, 1, "bar"},
+		{"both", func(ex *My{{.SecondName}}Example) { ex.SetId(3); ex.SetName("baz") }, 3, "baz"},
 	}
-}
-
-func TestSetId(t *testing.T) {
-	ex.SetId(2)
-	if id := ex.Id(); id != 2 {
-		t.Errorf(msgFail, "SetId", 2, id)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Every case gets its own value, so they don't depend on each other
+			ex := My{{.SecondName}}Example{id: 1, name: "foo"}
+			tt.set(&ex)
+			if id := ex.Id(); id != tt.wantId {
+				t.Errorf("Id() = %d, want %d", id, tt.wantId)
+			}
+			if name := ex.Name(); name != tt.wantName {
+				t.Errorf("Name() = %q, want %q", name, tt.wantName)
+			}
+		})
 	}
 }
 
-func TestSetName(t *testing.T) {
-	ex.SetName("bar")
-	if name := ex.Name(); name != "bar" {
-		t.Errorf(msgFail, "SetName", "bar", name)
+func BenchmarkNew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := New(i, "gobi"); err != nil {
+			b.Fatal(err)
+		}
 	}
 }
 
-func ExampleNew() {
-	id := 1
-	name := "gobi"
-	ex, err := New(id, name)
-	if err != nil {
-		fmt.Printf("Error: %v\n", err)
-		return
-	}
-
-	ex.SetId(ex.Id() + 1)
-  ex.SetName(ex.Name() + " is great")
-	fmt.Println(ex.Id(), ex.Name())
-	// Output: 2 gobi is great
+// FuzzNew checks New with random input. Run it with
+//
+//	go test -fuzz=FuzzNew
+func FuzzNew(f *testing.F) {
+	f.Add(1, "gobi")
+	f.Add(0, "")
+	f.Add(-1, "ünïcödé")
+	f.Fuzz(func(t *testing.T, id int, name string) {
+		ex, err := New(id, name)
+		if name == "" {
+			if !errors.Is(err, ErrEmptyName) {
+				t.Fatalf("New(%d, %q) error = %v, want %v", id, name, err, ErrEmptyName)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("New(%d, %q) error = %v", id, name, err)
+		}
+		if ex.Id() != id || ex.Name() != name {
+			t.Errorf("New(%d, %q) = %+v", id, name, *ex)
+		}
+	})
 }
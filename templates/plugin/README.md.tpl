@@ -0,0 +1,36 @@
+# {{.FirstName}}
+
+[Documentation online](http://godoc.org/{{.GoGetName}})
+
+**{{.FirstName}}** is a plugin written in Go generated automatically by `gobi`. Happy hacking!
+
+It implements the `{{.Stub.Name}}` interface of `{{.Stub.Path}}` with `{{.SecondName}}.{{.Stub.Impl}}`.
+
+## Install (with GOPATH set on your machine)
+----------
+
+* Step 1: Get the `{{.SecondName}}` package
+
+```
+go get {{.GoGetName}}
+```
+
+* Step 2 (Optional): Run tests
+
+```
+$ go test -v ./...
+```
+
+##Usage
+----------
+```
+import "{{.GoGetName}}"
+
+p := {{.SecondName}}.New() // p is a {{.Stub.Path}}.{{.Stub.Name}}
+```
+
+Every method returns `errNotImplemented` until it's implemented. Once you implement a method, remove it from `notImplemented` on `{{.SecondName}}_test.go` and add its own tests.
+
+##License
+----------
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
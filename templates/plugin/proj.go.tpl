@@ -0,0 +1,23 @@
+// Package {{.SecondName}} is a plugin implementing the {{.Stub.Name}} interface of {{.Stub.Path}}.
+package {{.SecondName}}
+
+import (
+{{range .Stub.Imports}}	{{.}}
+{{end}})
+
+// {{.Stub.Impl}} must implement {{.Stub.Interface}}
+var _ {{.Stub.Interface}} = (*{{.Stub.Impl}})(nil)
+
+// errNotImplemented is returned by the methods not implemented yet
+var errNotImplemented = errors.New("not implemented")
+
+// {{.Stub.Impl}} implements {{.Stub.Interface}}. It was automatically generated by ´gobi´.
+type {{.Stub.Impl}} struct{}
+
+// New creates a new *{{.Stub.Impl}}
+func New() *{{.Stub.Impl}} {
+	return &{{.Stub.Impl}}{}
+}
+{{range .Stub.Methods}}
+{{.}}
+{{end}}
\ No newline at end of file
@@ -0,0 +1,41 @@
+package {{.SecondName}}
+
+import (
+{{range .Stub.TestImports}}	{{.}}
+{{end}})
+
+// Methods of {{.Stub.Interface}} that are not implemented yet. Remove a
+// method from the list once it's implemented, and add its own tests
+var notImplemented = []string{ {{- range $i, $m := .Stub.MethodNames}}{{if $i}}, {{end}}{{printf "%q" $m}}{{end -}} }
+
+func TestImplements(t *testing.T) {
+	var p interface{} = New()
+	if _, ok := p.({{.Stub.Interface}}); !ok {
+		t.Errorf("*{{.Stub.Impl}} does not implement {{.Stub.Interface}}")
+	}
+}
+
+func TestNotImplemented(t *testing.T) {
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	p := reflect.ValueOf(New())
+	for _, name := range notImplemented {
+		m := p.MethodByName(name)
+		args := make([]reflect.Value, m.Type().NumIn())
+		for i := range args {
+			args[i] = reflect.Zero(m.Type().In(i))
+		}
+
+		call := m.Call
+		if m.Type().IsVariadic() {
+			call = m.CallSlice
+		}
+		for _, out := range call(args) {
+			if out.Type() != errorType {
+				continue
+			}
+			if err, _ := out.Interface().(error); !errors.Is(err, errNotImplemented) {
+				t.Errorf("%s returned %v, want %v", name, err, errNotImplemented)
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+VERSION := $(shell cat {{.RootPath}}/VERSION)
+LDFLAGS := -X {{.GoGetName}}/pkg/version.Version=$(VERSION)
+# Every directory under cmd/ is a command
+COMMANDS := $(notdir $(wildcard cmd/*))
+
+.PHONY: all build install test vet clean
+
+all: vet test build
+
+# Builds every command into bin/
+build:
+	mkdir -p bin
+	$(foreach cmd,$(COMMANDS),go build -ldflags "$(LDFLAGS)" -o bin/$(cmd) ./cmd/$(cmd) &&) true
+
+install:
+	go install -ldflags "$(LDFLAGS)" ./cmd/...
+
+test:
+	go test ./...
+
+vet:
+	go vet ./...
+
+clean:
+	rm -rf bin
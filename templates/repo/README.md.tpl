@@ -0,0 +1,49 @@
+# {{.FirstName}}
+
+[Documentation online](http://godoc.org/{{.GoGetName}})
+
+**{{.FirstName}}** is a repository of Go commands and packages generated automatically by `gobi`. Happy hacking!
+
+## Layout
+----------
+
+```
+cmd/{{.SecondName}}/     The {{.SecondName}} command. Add one directory per command.
+internal/greeter/  Code shared by the commands, that other projects can't import.
+pkg/version/       Code that other projects can import.
+Makefile           Builds, tests and installs all the commands.
+```
+
+## Install (with GOPATH set on your machine)
+----------
+
+* Step 1: Get the commands
+
+```
+go get {{.GoGetName}}/cmd/...
+```
+
+* Step 2 (Optional): Run tests
+
+```
+$ make test
+```
+
+* Step 3 (Optional): Build every command into `bin/`, with the version of the VERSION file
+
+```
+$ make build
+```
+
+##Usage
+----------
+
+```
+$ {{.SecondName}} gopher
+Hello, gopher!
+$ {{.SecondName}} -version
+```
+
+##License
+----------
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
@@ -0,0 +1,28 @@
+/*
+Package greeter has the logic of {{.FirstName}}. Being under internal/,
+only the packages of {{.FirstName}} can import it.
+*/
+package greeter
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrLongName is returned for names longer than MaxNameLength
+var ErrLongName = errors.New("name too long")
+
+// MaxNameLength accepted by Greet
+const MaxNameLength = 64
+
+// Greet returns the greeting of name, or of the world if empty
+func Greet(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "world"
+	}
+	if len(name) > MaxNameLength {
+		return "", ErrLongName
+	}
+	return "Hello, " + name + "!", nil
+}
@@ -0,0 +1,32 @@
+package greeter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGreet(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr error
+	}{
+		{"name", "gopher", "Hello, gopher!", nil},
+		{"empty", "", "Hello, world!", nil},
+		{"spaces", "  gopher ", "Hello, gopher!", nil},
+		{"too long", strings.Repeat("a", MaxNameLength+1), "", ErrLongName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Greet(tt.in)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Greet(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
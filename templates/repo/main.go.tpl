@@ -0,0 +1,36 @@
+/*
+{{.SecondName}} is the command of {{.FirstName}}, automatically generated by ´gobi´. Happy hacking!
+
+Usage:
+
+	{{.SecondName}} [-version] [NAME]
+
+Add more commands as new directories under cmd/, sharing the code
+of internal/ and pkg/.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"{{.GoGetName}}/internal/greeter"
+	"{{.GoGetName}}/pkg/version"
+)
+
+func main() {
+	showVersion := flag.Bool("version", false, "show the version")
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Println(version.String("{{.SecondName}}"))
+		return
+	}
+	message, err := greeter.Greet(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(message)
+}
@@ -0,0 +1,14 @@
+/*
+Package version tells the version of the commands of {{.FirstName}}.
+Being under pkg/, other projects can import it.
+*/
+package version
+
+// Version of {{.FirstName}}, set when building with
+// -ldflags "-X {{.GoGetName}}/pkg/version.Version=$(cat VERSION)" (see Makefile)
+var Version = "dev"
+
+// String returns the name of a command with the version
+func String(command string) string {
+	return command + " " + Version
+}
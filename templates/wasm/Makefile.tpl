@@ -0,0 +1,15 @@
+.PHONY: build serve test clean
+
+# Builds the WebAssembly program into web/
+build:
+	GOOS=js GOARCH=wasm go build -o web/main.wasm .
+
+# Serves web/ on http://localhost:8080
+serve: build
+	go run ./serve -dir web
+
+test:
+	go test ./...
+
+clean:
+	rm -f web/main.wasm
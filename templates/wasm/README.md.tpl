@@ -0,0 +1,46 @@
+# {{.FirstName}}
+
+[Documentation online](http://godoc.org/{{.GoGetName}})
+
+**{{.SecondName}}** is a WebAssembly program written in Go generated automatically by `gobi`. Happy hacking!
+
+It runs on the browser: `web/index.html` loads `web/main.wasm` with `web/wasm_exec.js`, copied from the Go installation that created the project. If you build it with another Go version, copy its `wasm_exec.js` again:
+
+```
+$ cp "$(go env GOROOT)/lib/wasm/wasm_exec.js" web/
+```
+
+## Install (with GOPATH set on your machine)
+----------
+
+* Step 1: Get the `{{.SecondName}}` package
+
+```
+go get -d {{.GoGetName}}
+```
+
+* Step 2 (Optional): Run tests, which test the code that doesn't depend on the browser
+
+```
+$ go test -v ./...
+```
+
+##Usage
+----------
+
+* Build it and serve it locally on http://localhost:8080
+
+```
+$ make serve
+```
+
+* Call it from the browser console
+
+```
+> greet("gopher")
+"Hello, gopher!"
+```
+
+##License
+----------
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
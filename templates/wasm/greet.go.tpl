@@ -0,0 +1,13 @@
+package main
+
+import "strings"
+
+// greet returns the greeting of name, or of the world if empty
+// It doesn't depend on syscall/js, so it is tested as any Go code
+func greet(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "world"
+	}
+	return "Hello, " + name + "!"
+}
@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestGreet(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"name", "gopher", "Hello, gopher!"},
+		{"empty", "", "Hello, world!"},
+		{"spaces", " gopher ", "Hello, gopher!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := greet(tt.in); got != tt.want {
+				t.Errorf("greet(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
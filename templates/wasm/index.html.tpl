@@ -0,0 +1,25 @@
+<!DOCTYPE html>
+<html>
+    <head>
+        <meta charset="utf-8">
+        <title>{{.FirstName}}</title>
+        <meta name="viewport" content="width=device-width, initial-scale=1.0">
+        <script src="wasm_exec.js"></script>
+        <script>
+            const go = new Go();
+            WebAssembly.instantiateStreaming(fetch("main.wasm"), go.importObject)
+                .then((result) => go.run(result.instance))
+                .catch((err) => {
+                    document.getElementById("status").textContent = "Failed to load main.wasm: " + err;
+                });
+        </script>
+    </head>
+    <body>
+        <p id="status">Loading...</p>
+        <p id="output"></p>
+        <p>Call <code>greet("gopher")</code> from the browser console.</p>
+        <footer>
+            {{.FirstName}} generated automatically by <a href="https://www.github.com/fern4lvarez/gobi" target="_blank" rel="noopener">gobi</a>.
+        </footer>
+    </body>
+</html>
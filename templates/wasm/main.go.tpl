@@ -0,0 +1,35 @@
+//go:build js && wasm
+
+/*
+{{.SecondName}} is a WebAssembly program automatically generated by ´gobi´. Happy hacking!
+
+Build it with ´make build´, which runs
+
+	GOOS=js GOARCH=wasm go build -o web/main.wasm .
+
+and serve web/ with ´make serve´. index.html loads it with wasm_exec.js.
+*/
+package main
+
+import (
+	"syscall/js"
+)
+
+func main() {
+	document := js.Global().Get("document")
+
+	// greet is called from JavaScript as greet(name)
+	js.Global().Set("greet", js.FuncOf(func(this js.Value, args []js.Value) any {
+		name := ""
+		if len(args) > 0 {
+			name = args[0].String()
+		}
+		return greet(name)
+	}))
+
+	document.Call("getElementById", "output").Set("textContent", greet("WebAssembly"))
+	document.Call("getElementById", "status").Set("textContent", "Ready")
+
+	// Keep the program running, so JavaScript can call greet
+	select {}
+}
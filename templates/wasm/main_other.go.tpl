@@ -0,0 +1,14 @@
+//go:build !(js && wasm)
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// main explains how to build {{.SecondName}} when it is not built for WebAssembly
+func main() {
+	fmt.Fprintln(os.Stderr, "{{.SecondName}} runs on the browser, build it with: GOOS=js GOARCH=wasm go build -o web/main.wasm .")
+	os.Exit(1)
+}
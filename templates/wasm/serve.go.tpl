@@ -0,0 +1,35 @@
+/*
+serve is a static file server for the local development of {{.SecondName}}.
+
+	$ go run ./serve -addr :8080 -dir web
+
+It is not meant for production, where any static file server can be used.
+*/
+package main
+
+import (
+	"flag"
+	"log"
+	"mime"
+	"net/http"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	dir := flag.String("dir", "web", "directory to serve")
+	flag.Parse()
+
+	// Browsers only compile WebAssembly served with its type
+	mime.AddExtensionType(".wasm", "application/wasm")
+
+	log.Printf("Serving %s on http://localhost%s", *dir, *addr)
+	log.Fatal(http.ListenAndServe(*addr, noCache(http.FileServer(http.Dir(*dir)))))
+}
+
+// noCache so every reload gets the last build
+func noCache(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		next.ServeHTTP(w, r)
+	})
+}
@@ -2,7 +2,9 @@
 
 [Documentation online](http://godoc.org/{{.GoGetName}})
 
-**{{.SecondName}}** is a web application written in Go generated automatically by `gobi`. It contains a Procfile and a .godir, so it's ready to be deployed on platforms like Heroku or cloudControl. Happy hacking!
+**{{.SecondName}}** is a web application written in Go generated automatically by `gobi`. Happy hacking!
+
+The server logs every request as JSON, gives it an `X-Request-Id`, recovers from panics and shuts down gracefully on `SIGINT` or `SIGTERM`. Its templates (`templates/`) and static assets (`static/`) are embedded in the binary. No asset is loaded from a CDN, so the site works offline and under its strict Content Security Policy. Bootstrap (`static/css/bootstrap.min.css`, `static/js/bootstrap.min.js`) and jQuery (`static/js/jquery.min.js`) are vendored there; add your own styles to `static/css/app.css`.
 
 ## Install (with GOPATH set on your machine)
 ----------
@@ -26,9 +28,53 @@ $ go test -v ./...
 
 ```
 $ {{.SecondName}}
-Listening on 5555 ...
+{"time":"...","level":"INFO","msg":"listening","port":"{{.Port}}"}
+```
+
+* Choose another port
+
+```
+$ PORT=8080 {{.SecondName}}
 ```
 
+{{if or .Opts.Docker .HasContainerDeploy}}* Run it on a container, from the directory of `{{.SecondName}}`
+
+```
+$ docker compose up --build
+```
+
+The `Dockerfile` builds a static binary and runs it as a non-root user on a distroless image.
+
+{{end}}* Deploy it
+{{if .DeploysTo "heroku"}}
+It contains a `Procfile` and a `.godir`, so it's ready to be deployed on platforms like Heroku or cloudControl.
+{{end}}{{if .DeploysTo "k8s"}}
+Push the image built by the `Dockerfile` to your registry, set it on `k8s/deployment.yaml`, and apply the manifests:
+
+```
+$ kubectl apply -f k8s/
+```
+{{end}}{{if .DeploysTo "systemd"}}
+Install the binary on `/usr/local/bin` and enable its unit:
+
+```
+$ sudo cp {{.SecondName}}.service /etc/systemd/system/
+$ sudo systemctl enable --now {{.SecondName}}
+```
+{{end}}{{if .DeploysTo "fly"}}
+Deploy its image to Fly.io, with `fly.toml` as its configuration:
+
+```
+$ fly deploy
+```
+{{end}}
+* Health checks
+
+| Endpoint   | Answers                                        |
+|------------|------------------------------------------------|
+| `/healthz` | 200 while the process is alive                 |
+| `/readyz`  | 200 while accepting traffic, 503 when stopping |
+
 ##License
 ----------
-{{.FirstName}} is {{.License}} licensed.
\ No newline at end of file
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
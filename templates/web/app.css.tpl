@@ -0,0 +1,12 @@
+body {
+	padding-top: 20px;
+}
+
+pre {
+	font-size: 10px;
+}
+
+footer {
+	margin-top: 20px;
+	color: #999;
+}
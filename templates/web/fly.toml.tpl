@@ -0,0 +1,21 @@
+app = "{{.ServiceName}}"
+
+[build]
+  dockerfile = "Dockerfile"
+
+[env]
+  PORT = "{{.Port}}"
+
+[http_service]
+  internal_port = {{.Port}}
+  force_https = true
+  auto_stop_machines = "stop"
+  auto_start_machines = true
+  min_machines_running = 0
+
+[[http_service.checks]]
+  method = "GET"
+  path = "/healthz"
+  interval = "15s"
+  timeout = "2s"
+  grace_period = "5s"
@@ -1,12 +1,14 @@
-    <!DOCTYPE html>
-    <html>
-        <head>
-            <title>{{.FirstName}} Web App</title>
-            <meta name="viewport" content="width=device-width, initial-scale=1.0">
-            <!-- Bootstrap -->
-            <link href="//netdna.bootstrapcdn.com/twitter-bootstrap/2.3.2/css/bootstrap-combined.min.css" rel="stylesheet" media="screen">
-        </head>
-        <body>
+<!DOCTYPE html>
+<html>
+    <head>
+        <meta charset="utf-8">
+        <title>{{.FirstName}} Web App</title>
+        <meta name="viewport" content="width=device-width, initial-scale=1.0">
+        <link href="/static/css/bootstrap.min.css" rel="stylesheet" media="screen">
+        <link href="/static/css/app.css" rel="stylesheet" media="screen">
+    </head>
+    <body>
+        <div class="container">
             <pre>
               _     _             _     _                                                _                                _                _    _             _ 
              | |   (_)           (_)   | |                                              | |                              | |              | |  (_)           | |
@@ -17,10 +19,11 @@
    __/ |                                                    __/ |                                   | |   | |     __/ |                                  __/ |  
   |___/                                                    |___/                                    |_|   |_|    |___/                                  |___/  
             </pre>
-            <script src="http://code.jquery.com/jquery.js"></script>
-            <script src="//netdna.bootstrapcdn.com/twitter-bootstrap/2.3.2/js/bootstrap.min.js"></script>
-                <footer>
-                    {{.FirstName}}'s site generated automatically by <a href="https://www.github.com/fern4lvarez/gobi" target="_blank">gobi</a>.
-                </footer>
-        </body>
-    </html>
\ No newline at end of file
+            <footer>
+                {{.FirstName}}'s site generated automatically by <a href="https://www.github.com/fern4lvarez/gobi" target="_blank" rel="noopener">gobi</a>.
+            </footer>
+        </div>
+        <script src="/static/js/jquery.min.js"></script>
+        <script src="/static/js/bootstrap.min.js"></script>
+    </body>
+</html>
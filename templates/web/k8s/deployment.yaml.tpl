@@ -0,0 +1,49 @@
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{.ServiceName}}
+  labels:
+    app.kubernetes.io/name: {{.ServiceName}}
+spec:
+  replicas: 2
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: {{.ServiceName}}
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/name: {{.ServiceName}}
+    spec:
+      # Longer than the shutdown timeout of the server, so requests are drained
+      terminationGracePeriodSeconds: 30
+      containers:
+        - name: {{.ServiceName}}
+          # Built with the Dockerfile, push it to your registry
+          image: {{.ServiceName}}:latest
+          ports:
+            - name: http
+              containerPort: {{.Port}}
+          env:
+            - name: PORT
+              value: "{{.Port}}"
+          readinessProbe:
+            httpGet:
+              path: /readyz
+              port: http
+          livenessProbe:
+            httpGet:
+              path: /healthz
+              port: http
+          resources:
+            requests:
+              cpu: 50m
+              memory: 32Mi
+            limits:
+              memory: 128Mi
+          securityContext:
+            runAsNonRoot: true
+            runAsUser: 65532
+            allowPrivilegeEscalation: false
+            readOnlyRootFilesystem: true
+            capabilities:
+              drop: ["ALL"]
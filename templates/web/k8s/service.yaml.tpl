@@ -0,0 +1,13 @@
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{.ServiceName}}
+  labels:
+    app.kubernetes.io/name: {{.ServiceName}}
+spec:
+  selector:
+    app.kubernetes.io/name: {{.ServiceName}}
+  ports:
+    - name: http
+      port: 80
+      targetPort: http
@@ -1,27 +1,238 @@
+/*
+{{.SecondName}} is a web application automatically generated by ´gobi´. Happy hacking!
+
+It listens on $PORT ({{.Port}} by default), logs every request as JSON and
+shuts down gracefully on SIGINT or SIGTERM. Templates and static assets
+are embedded in the binary.
+*/
 package main
 
 import (
-	"fmt"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"errors"
 	"html/template"
+	"io/fs"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
 )
 
-var port = "5555"
+//go:embed templates static
+var assets embed.FS
+
+// Header with the id of every request
+const requestIDHeader = "X-Request-Id"
+
+// Content Security Policy of every response. Assets are served
+// from /static, so nothing has to be loaded from other origins
+const contentSecurityPolicy = "default-src 'self'; frame-ancestors 'none'"
+
+// Time given to running requests when shutting down
+const shutdownTimeout = 15 * time.Second
+
+// contextKey of the values stored on the request context
+type contextKey int
+
+const requestIDKey contextKey = iota
+
+// server of {{.SecondName}}
+type server struct {
+	logger    *slog.Logger
+	templates *template.Template
+	static    http.Handler
+	ready     atomic.Bool
+}
 
 func main() {
-	if os.Getenv("PORT") != "" {
-		 port = os.Getenv("PORT")
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	if err := run(logger); err != nil {
+		logger.Error("server failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run the server until it fails or receives SIGINT or SIGTERM
+func run(logger *slog.Logger) error {
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "{{.Port}}"
+	}
+	s, err := newServer(logger)
+	if err != nil {
+		return err
 	}
-	http.HandleFunc("/", indexHandler)
-	http.HandleFunc("/static/", func(w http.ResponseWriter, r *http.Request) {
-      http.ServeFile(w, r, r.URL.Path[1:])
-  })
-	fmt.Println("Listening on", port, "...")
-	http.ListenAndServe(":"+port, nil)
+	srv := &http.Server{
+		Addr:              ":" + port,
+		Handler:           s.routes(),
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       60 * time.Second,
+		ErrorLog:          slog.NewLogLogger(logger.Handler(), slog.LevelError),
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	errc := make(chan error, 1)
+	go func() {
+		logger.Info("listening", "port", port)
+		errc <- srv.ListenAndServe()
+	}()
+	s.ready.Store(true)
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	s.ready.Store(false)
+	logger.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errc; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// newServer parsing the embedded templates
+func newServer(logger *slog.Logger) (*server, error) {
+	templates, err := template.ParseFS(assets, "templates/*.html")
+	if err != nil {
+		return nil, err
+	}
+	static, err := fs.Sub(assets, "static")
+	if err != nil {
+		return nil, err
+	}
+	return &server{
+		logger:    logger,
+		templates: templates,
+		static:    http.StripPrefix("/static/", http.FileServer(http.FS(static))),
+	}, nil
+}
+
+// routes of the server with all its middleware
+func (s *server) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("/static/", s.static)
+	mux.HandleFunc("/healthz", s.handleHealth)
+	mux.HandleFunc("/readyz", s.handleReady)
+	mux.HandleFunc("/", s.handleIndex)
+	return s.withRequestID(s.withLogging(s.withRecovery(withSecurityHeaders(mux))))
+}
+
+// handleIndex renders the index page
+func (s *server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	var buf bytes.Buffer
+	if err := s.templates.ExecuteTemplate(&buf, "index.html", nil); err != nil {
+		s.logger.Error("rendering index", "error", err, "request_id", requestID(r.Context()))
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	buf.WriteTo(w)
+}
+
+// handleHealth tells the process is alive
+func (s *server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("ok\n"))
+}
+
+// handleReady tells the server accepts traffic, so it fails while shutting down
+func (s *server) handleReady(w http.ResponseWriter, r *http.Request) {
+	if !s.ready.Load() {
+		http.Error(w, "not ready", http.StatusServiceUnavailable)
+		return
+	}
+	w.Write([]byte("ok\n"))
+}
+
+// withRequestID gives every request an id, taken from the
+// X-Request-Id header if given, and sends it back on the response
+func (s *server) withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(requestIDHeader)
+		if id == "" {
+			id = newRequestID()
+		}
+		w.Header().Set(requestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey, id)))
+	})
+}
+
+// withSecurityHeaders restricts what browsers load and run on the site
+func withSecurityHeaders(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Security-Policy", contentSecurityPolicy)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withLogging logs every request once served
+func (s *server) withLogging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		s.logger.Info("request",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rec.status,
+			"duration", time.Since(start),
+			"request_id", requestID(r.Context()))
+	})
+}
+
+// withRecovery answers with an internal error when a handler panics
+func (s *server) withRecovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if err := recover(); err != nil {
+				s.logger.Error("panic", "error", err, "request_id", requestID(r.Context()))
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// statusRecorder keeps the status code written to a response
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+// requestID stored on a context
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
 }
 
-func indexHandler(res http.ResponseWriter, req *http.Request) {
-	t, _ := template.ParseFiles("index.html")
-	t.ExecuteTemplate(res, "index.html", nil)
+// newRequestID returns a random id
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
 }
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestServer(t *testing.T) *server {
+	t.Helper()
+	s, err := newServer(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestRoutes(t *testing.T) {
+	s := newTestServer(t)
+	s.ready.Store(true)
+	tests := []struct {
+		name   string
+		path   string
+		status int
+		body   string
+	}{
+		{"index", "/", http.StatusOK, "{{.FirstName}}"},
+		{"health", "/healthz", http.StatusOK, "ok"},
+		{"ready", "/readyz", http.StatusOK, "ok"},
+		{"bootstrap styles", "/static/css/bootstrap.min.css", http.StatusOK, "Bootstrap"},
+		{"bootstrap scripts", "/static/js/bootstrap.min.js", http.StatusOK, "Bootstrap"},
+		{"jquery", "/static/js/jquery.min.js", http.StatusOK, "jQuery"},
+		{"app styles", "/static/css/app.css", http.StatusOK, ""},
+		{"not found", "/missing", http.StatusNotFound, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			s.routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if !strings.Contains(rec.Body.String(), tt.body) {
+				t.Errorf("body does not contain %q", tt.body)
+			}
+			if rec.Header().Get(requestIDHeader) == "" {
+				t.Error("missing request id")
+			}
+			if rec.Header().Get("Content-Security-Policy") != contentSecurityPolicy {
+				t.Error("missing content security policy")
+			}
+		})
+	}
+}
+
+func TestIndexUsesLocalAssets(t *testing.T) {
+	s := newTestServer(t)
+	rec := httptest.NewRecorder()
+	s.routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	body := rec.Body.String()
+	for _, external := range []string{"http://", "src=\"//", "href=\"//"} {
+		if strings.Contains(body, external) {
+			t.Errorf("index loads assets from other origins (%s)", external)
+		}
+	}
+}
+
+func TestNotReady(t *testing.T) {
+	s := newTestServer(t)
+	rec := httptest.NewRecorder()
+	s.routes().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestRequestIDIsKept(t *testing.T) {
+	s := newTestServer(t)
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	req.Header.Set(requestIDHeader, "gobi")
+	rec := httptest.NewRecorder()
+	s.routes().ServeHTTP(rec, req)
+	if id := rec.Header().Get(requestIDHeader); id != "gobi" {
+		t.Errorf("request id = %q, want %q", id, "gobi")
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	s := newTestServer(t)
+	h := s.withRecovery(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,19 @@
+[Unit]
+Description={{.SecondName}}: {{.Description}}
+After=network-online.target
+Wants=network-online.target
+
+[Service]
+ExecStart=/usr/local/bin/{{.SecondName}}
+Environment=PORT={{.Port}}
+Restart=on-failure
+# SIGTERM drains the server, longer than its shutdown timeout
+TimeoutStopSec=30
+DynamicUser=yes
+NoNewPrivileges=yes
+ProtectSystem=strict
+ProtectHome=yes
+PrivateTmp=yes
+
+[Install]
+WantedBy=multi-user.target
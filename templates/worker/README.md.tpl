@@ -0,0 +1,41 @@
+# {{.FirstName}}
+
+[Documentation online](http://godoc.org/{{.GoGetName}})
+
+**{{.SecondName}}** is a background worker written in Go generated automatically by `gobi`. Happy hacking!
+
+It runs jobs with a pool of workers. On `SIGINT` or `SIGTERM` it stops taking new jobs and waits for the running ones to finish, so none is left half done.
+
+Jobs come from a `Source`. The example `MemorySource` is fed with a job every second; replace it with your queue by implementing:
+
+```
+type Source interface {
+	Next(ctx context.Context) (Job, error)
+}
+```
+
+## Install (with GOPATH set on your machine)
+----------
+
+* Step 1: Get the `{{.SecondName}}` package
+
+```
+go get {{.GoGetName}}
+```
+
+* Step 2 (Optional): Run tests
+
+```
+$ go test -v ./...
+```
+
+##Usage
+----------
+
+```
+$ {{.SecondName}} -concurrency 8 -drain-timeout 1m
+```
+
+##License
+----------
+{{.FirstName}} is {{.License}} licensed, see {{.LicenseFileNames}}.
\ No newline at end of file
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ErrNoMoreJobs is returned by a Source that won't have more jobs
+var ErrNoMoreJobs = errors.New("no more jobs")
+
+// Job to run by the workers
+type Job struct {
+	ID      string
+	Payload []byte
+}
+
+// Source of jobs, such as a queue. It must be safe for concurrent use
+type Source interface {
+	// Next job, blocking until there is one. It returns ErrNoMoreJobs
+	// when the source is exhausted, or the error of ctx when cancelled
+	Next(ctx context.Context) (Job, error)
+}
+
+// Handler runs a job. Returned errors are logged and don't stop the Pool
+type Handler func(ctx context.Context, job Job) error
+
+// Pool of workers that run jobs at the same time
+type Pool struct {
+	Concurrency int
+	Handler     Handler
+	Logger      *slog.Logger
+}
+
+// NewPool of concurrency workers running jobs with the handler
+func NewPool(concurrency int, handler Handler, logger *slog.Logger) *Pool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Pool{Concurrency: concurrency, Handler: handler, Logger: logger}
+}
+
+// Run jobs of the source until it has no more jobs, it fails or ctx is
+// cancelled. Running jobs are not interrupted by ctx: Run waits for them
+// to finish, so no job is left half done
+func (p *Pool) Run(ctx context.Context, source Source) error {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobCtx := context.WithoutCancel(ctx)
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for i := 0; i < p.Concurrency; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for {
+				job, err := source.Next(fetchCtx)
+				if err != nil {
+					// A failing source stops every worker
+					if !errors.Is(err, ErrNoMoreJobs) && fetchCtx.Err() == nil {
+						once.Do(func() { firstErr = err })
+						cancel()
+					}
+					return
+				}
+				p.handle(jobCtx, worker, job)
+			}
+		}(i)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// handle a job, logging its result and recovering from panics
+func (p *Pool) handle(ctx context.Context, worker int, job Job) {
+	start := time.Now()
+	logger := p.Logger.With("job", job.ID, "worker", worker)
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Error("job panicked", "panic", r, "duration", time.Since(start))
+		}
+	}()
+	if err := p.Handler(ctx, job); err != nil {
+		logger.Error("job failed", "error", err, "duration", time.Since(start))
+		return
+	}
+	logger.Info("job done", "duration", time.Since(start))
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// newTestSource with n jobs, closed so it has no more
+func newTestSource(t *testing.T, n int) *MemorySource {
+	t.Helper()
+	source := NewMemorySource(n)
+	for i := 0; i < n; i++ {
+		if err := source.Push(context.Background(), Job{ID: fmt.Sprint(i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	source.Close()
+	return source
+}
+
+func TestPoolRunsAllJobs(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler func(calls int64) error
+	}{
+		{"succeeding", func(int64) error { return nil }},
+		{"failing", func(int64) error { return errors.New("boom") }},
+		{"panicking", func(calls int64) error {
+			if calls%2 == 0 {
+				panic("boom")
+			}
+			return nil
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int64
+			pool := NewPool(4, func(ctx context.Context, job Job) error {
+				return tt.handler(atomic.AddInt64(&calls, 1))
+			}, discardLogger)
+			if err := pool.Run(context.Background(), newTestSource(t, 20)); err != nil {
+				t.Fatal(err)
+			}
+			if calls != 20 {
+				t.Errorf("ran %d jobs, want 20", calls)
+			}
+		})
+	}
+}
+
+func TestPoolConcurrency(t *testing.T) {
+	var running, max int64
+	pool := NewPool(3, func(ctx context.Context, job Job) error {
+		n := atomic.AddInt64(&running, 1)
+		for {
+			m := atomic.LoadInt64(&max)
+			if n <= m || atomic.CompareAndSwapInt64(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt64(&running, -1)
+		return nil
+	}, discardLogger)
+	if err := pool.Run(context.Background(), newTestSource(t, 12)); err != nil {
+		t.Fatal(err)
+	}
+	if max > 3 {
+		t.Errorf("%d jobs ran at the same time, want at most 3", max)
+	}
+}
+
+func TestPoolDrainsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started, release := make(chan struct{}), make(chan struct{})
+	var finished int64
+	pool := NewPool(1, func(jobCtx context.Context, job Job) error {
+		close(started)
+		<-release
+		if jobCtx.Err() != nil {
+			t.Error("running jobs should not be cancelled")
+		}
+		atomic.AddInt64(&finished, 1)
+		return nil
+	}, discardLogger)
+
+	source := NewMemorySource(1)
+	source.Push(ctx, Job{ID: "1"})
+	done := make(chan error)
+	go func() { done <- pool.Run(ctx, source) }()
+
+	<-started
+	cancel()
+	select {
+	case <-done:
+		t.Fatal("Run returned before the running job finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if finished != 1 {
+		t.Error("the running job did not finish")
+	}
+}
+
+// failingSource always fails
+type failingSource struct{}
+
+func (failingSource) Next(ctx context.Context) (Job, error) {
+	return Job{}, errors.New("source down")
+}
+
+func TestPoolStopsOnSourceError(t *testing.T) {
+	pool := NewPool(2, func(ctx context.Context, job Job) error { return nil }, discardLogger)
+	if err := pool.Run(context.Background(), failingSource{}); err == nil {
+		t.Error("expected the error of the source")
+	}
+}
+
+func TestRunDrainTimeout(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	pool := NewPool(1, func(ctx context.Context, job Job) error {
+		close(started)
+		time.Sleep(time.Second)
+		return nil
+	}, discardLogger)
+	source := NewMemorySource(1)
+	source.Push(ctx, Job{ID: "slow"})
+
+	go func() {
+		<-started
+		cancel()
+	}()
+	if err := run(ctx, pool, source, 10*time.Millisecond, discardLogger); !errors.Is(err, errDrainTimeout) {
+		t.Errorf("err = %v, want %v", err, errDrainTimeout)
+	}
+}
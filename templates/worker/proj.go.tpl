@@ -0,0 +1,94 @@
+/*
+{{.SecondName}} is a background worker automatically generated by ´gobi´. Happy hacking!
+
+It runs jobs from a Source with a pool of -concurrency workers. On SIGINT
+or SIGTERM it stops taking jobs and waits up to -drain-timeout for the
+running ones to finish.
+
+The jobs come from an in-memory source fed every -interval, replace it
+with your queue by implementing the Source interface.
+*/
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// errDrainTimeout is returned when the running jobs don't finish in time
+var errDrainTimeout = errors.New("drain timed out, running jobs were interrupted")
+
+func main() {
+	concurrency := flag.Int("concurrency", runtime.NumCPU(), "number of jobs run at the same time")
+	drainTimeout := flag.Duration("drain-timeout", 30*time.Second, "time given to running jobs when stopping")
+	interval := flag.Duration("interval", time.Second, "time between the example jobs")
+	flag.Parse()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	source := NewMemorySource(*concurrency)
+	go produce(ctx, source, *interval)
+	pool := NewPool(*concurrency, process, logger)
+	if err := run(ctx, pool, source, *drainTimeout, logger); err != nil {
+		logger.Error("worker failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run the pool until the source has no more jobs or ctx is cancelled,
+// giving the running jobs drainTimeout to finish in the last case
+func run(ctx context.Context, pool *Pool, source Source, drainTimeout time.Duration, logger *slog.Logger) error {
+	logger.Info("starting", "concurrency", pool.Concurrency)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- pool.Run(ctx, source)
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+	}
+	logger.Info("draining", "timeout", drainTimeout)
+	select {
+	case err := <-errc:
+		logger.Info("stopped")
+		return err
+	case <-time.After(drainTimeout):
+		return errDrainTimeout
+	}
+}
+
+// process is the Handler of the jobs. Replace it with your own
+func process(ctx context.Context, job Job) error {
+	select {
+	case <-time.After(100 * time.Millisecond):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// produce example jobs on the source until ctx is cancelled
+func produce(ctx context.Context, source *MemorySource, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := 1; ; i++ {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			source.Push(ctx, Job{ID: fmt.Sprint(i), Payload: []byte("example")})
+		}
+	}
+}
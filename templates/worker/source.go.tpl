@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// MemorySource is a Source that keeps its jobs on memory,
+// so they are lost when the process stops
+type MemorySource struct {
+	jobs      chan Job
+	closeOnce sync.Once
+}
+
+// NewMemorySource that holds up to size jobs before Push blocks
+func NewMemorySource(size int) *MemorySource {
+	return &MemorySource{jobs: make(chan Job, size)}
+}
+
+// Push a job, blocking while the source is full. It must not be
+// called after Close
+func (s *MemorySource) Push(ctx context.Context, job Job) error {
+	select {
+	case s.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close the source. The jobs already pushed are still returned by Next
+func (s *MemorySource) Close() {
+	s.closeOnce.Do(func() { close(s.jobs) })
+}
+
+// Next job of the source
+func (s *MemorySource) Next(ctx context.Context) (Job, error) {
+	select {
+	case job, ok := <-s.jobs:
+		if !ok {
+			return Job{}, ErrNoMoreJobs
+		}
+		return job, nil
+	case <-ctx.Done():
+		return Job{}, ctx.Err()
+	}
+}
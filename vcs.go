@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// VCS describes a version control system and the way gobi uses it.
+// Commands are split by spaces and every field can contain the
+// placeholders {branch}, {name}, {email}, {author}, {message},
+// {project} and {remote}
+type VCS struct {
+	Name string
+	Cmd  string
+	// Metadata file or directory found on the root of a repository
+	Meta string
+
+	// Ignore file and the template used to create it
+	IgnoreFile     string
+	IgnoreTemplate string
+
+	// DefaultBranch is used when no branch is given. BranchCmd is
+	// only run to switch to a different branch than the default
+	DefaultBranch string
+	BranchCmd     string
+
+	InitCmd   []string
+	CommitCmd []string
+
+	// RemoteCmd sets the remote, RemoteUpdateCmd is tried if it fails
+	RemoteCmd       string
+	RemoteUpdateCmd string
+
+	// setRemote is used when the VCS has no command to set the remote
+	setRemote func(dir, remote string) error
+}
+
+// All supported version control systems
+var vcsList = []*VCS{vcsGit, vcsHg, vcsFossil}
+
+var vcsGit = &VCS{
+	Name:           "git",
+	Cmd:            "git",
+	Meta:           ".git",
+	IgnoreFile:     ".gitignore",
+	IgnoreTemplate: "gitignore.tpl",
+	DefaultBranch:  "master",
+	InitCmd:        []string{"init", "symbolic-ref HEAD refs/heads/{branch}"},
+	CommitCmd: []string{"add --all",
+		"-c user.name={name} -c user.email={email} commit --quiet -m {message}"},
+	RemoteCmd:       "remote add origin {remote}",
+	RemoteUpdateCmd: "remote set-url origin {remote}",
+}
+
+var vcsHg = &VCS{
+	Name:           "hg",
+	Cmd:            "hg",
+	Meta:           ".hg",
+	IgnoreFile:     ".hgignore",
+	IgnoreTemplate: "hgignore.tpl",
+	DefaultBranch:  "default",
+	BranchCmd:      "branch {branch}",
+	InitCmd:        []string{"init"},
+	CommitCmd:      []string{"add", "commit -u {author} -m {message}"},
+	setRemote:      hgSetRemote,
+}
+
+var vcsFossil = &VCS{
+	Name:           "fossil",
+	Cmd:            "fossil",
+	Meta:           ".fslckout",
+	IgnoreFile:     filepath.Join(".fossil-settings", "ignore-glob"),
+	IgnoreTemplate: "fossil-ignore-glob.tpl",
+	DefaultBranch:  "trunk",
+	InitCmd:        []string{"init .{project}.fossil", "open --force .{project}.fossil"},
+	CommitCmd:      []string{"addremove", "commit --user-override {name} -m {message}"},
+	RemoteCmd:      "remote-url {remote}",
+}
+
+// vcsByName returns the VCS with the given name or nil if not supported
+func vcsByName(name string) *VCS {
+	for _, v := range vcsList {
+		if strings.EqualFold(v.Name, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+// validateVCS: Must be one of the supported version control systems
+func validateVCS(name string) bool {
+	return vcsByName(name) != nil
+}
+
+// VCS returns the version control system of the Project,
+// the one given on the command line or else the default of its host
+func (proj Project) VCS() *VCS {
+	if proj.Opts.VCS != "" {
+		return vcsByName(proj.Opts.VCS)
+	}
+	if v := vcsByName(hosts[proj.Host].VCS); v != nil {
+		return v
+	}
+	return vcsGit
+}
+
+// InitRepository creates a repository on the root directory of the Project,
+// commits all generated files as the user and sets the remote
+// An existing repository is left untouched
+func (proj Project) InitRepository() {
+	vcs := proj.VCS()
+	dir := proj.RootDir()
+	if _, err := os.Stat(filepath.Join(dir, vcs.Meta)); err == nil {
+		repositoryExists(dir)
+		return
+	}
+	branch := proj.Opts.Branch
+	if branch == "" {
+		branch = vcs.DefaultBranch
+	}
+	vars := map[string]string{
+		"{branch}":  branch,
+		"{name}":    proj.UserName,
+		"{email}":   proj.UserEmail,
+		"{author}":  fmt.Sprintf("%s <%s>", proj.UserName, proj.UserEmail),
+		"{message}": "Initial commit by gobi",
+		"{project}": proj.FirstName,
+		"{remote}":  proj.Remote(),
+	}
+
+	cmds := vcs.InitCmd
+	if vcs.BranchCmd != "" && branch != vcs.DefaultBranch {
+		cmds = append(cmds[:len(cmds):len(cmds)], vcs.BranchCmd)
+	}
+	cmds = append(cmds[:len(cmds):len(cmds)], vcs.CommitCmd...)
+	for _, cmd := range cmds {
+		if err := runCommand(dir, vcs.Cmd, expandCmd(cmd, vars)...); err != nil {
+			commandFailed(err)
+		}
+	}
+
+	var err error
+	if vcs.setRemote != nil {
+		err = vcs.setRemote(dir, vars["{remote}"])
+	} else if err = runCommand(dir, vcs.Cmd, expandCmd(vcs.RemoteCmd, vars)...); err != nil && vcs.RemoteUpdateCmd != "" {
+		err = runCommand(dir, vcs.Cmd, expandCmd(vcs.RemoteUpdateCmd, vars)...)
+	}
+	if err != nil {
+		commandFailed(err)
+	}
+	remoteAdded(vars["{remote}"])
+}
+
+// Remote returns the url of the repository of the Project
+// following the format of its host
+func (proj Project) Remote() string {
+	return fmt.Sprintf(hosts[proj.Host].Remote, proj.RootGoGetName())
+}
+
+// expandCmd splits a command in its arguments and fills the placeholders
+func expandCmd(cmd string, vars map[string]string) []string {
+	args := strings.Fields(cmd)
+	for i, arg := range args {
+		for k, v := range vars {
+			arg = strings.Replace(arg, k, v, -1)
+		}
+		args[i] = arg
+	}
+	return args
+}
+
+// hgSetRemote writes the default path of a Mercurial repository
+func hgSetRemote(dir, remote string) error {
+	hgrc := filepath.Join(dir, ".hg", "hgrc")
+	b, _ := ioutil.ReadFile(hgrc)
+	if strings.Contains(string(b), "[paths]") {
+		return nil
+	}
+	f, err := os.OpenFile(hgrc, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = fmt.Fprintf(f, "[paths]\ndefault = %s\n", remote)
+	return err
+}
+
+// runCommand on a directory, returning its output on failure
+func runCommand(dir, name string, args ...string) error {
+	commandRun(name, args)
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s: %v\n%s", name, err, out)
+	}
+	return nil
+}